@@ -0,0 +1,473 @@
+package geom
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// MultiPolygon is a set of polygon rings filled using even-odd rule, so holes can have any winding.
+// Results of boolean operations have clockwise outer rings each followed by its counter-clockwise holes.
+// Boolean operations merge vertices and intersections closer than Delta into a single vertex.
+type MultiPolygon[T Number] struct {
+	Polygons []Polygon[T]
+}
+
+// MultiPol is shorthand for MultiPolygon{polygons}.
+func MultiPol[T Number](polygons ...Polygon[T]) MultiPolygon[T] {
+	return MultiPolygon[T]{polygons}
+}
+
+// Union creates a new MultiPolygon covering points in any of the polygons.
+func (p Polygon[T]) Union(polygon Polygon[T]) MultiPolygon[T] {
+	return MultiPol(p).Union(MultiPol(polygon))
+}
+
+// Intersect creates a new MultiPolygon covering points in both polygons.
+func (p Polygon[T]) Intersect(polygon Polygon[T]) MultiPolygon[T] {
+	return MultiPol(p).Intersect(MultiPol(polygon))
+}
+
+// Difference creates a new MultiPolygon covering points of the current polygon not in the given polygon.
+func (p Polygon[T]) Difference(polygon Polygon[T]) MultiPolygon[T] {
+	return MultiPol(p).Difference(MultiPol(polygon))
+}
+
+// Xor creates a new MultiPolygon covering points in exactly one of the polygons.
+func (p Polygon[T]) Xor(polygon Polygon[T]) MultiPolygon[T] {
+	return MultiPol(p).Xor(MultiPol(polygon))
+}
+
+// Union creates a new MultiPolygon covering points in any of the multi-polygons.
+func (m MultiPolygon[T]) Union(polygon MultiPolygon[T]) MultiPolygon[T] {
+	return booleanOperation(m, polygon, func(in1, in2 bool) bool { return in1 || in2 })
+}
+
+// Intersect creates a new MultiPolygon covering points in both multi-polygons.
+func (m MultiPolygon[T]) Intersect(polygon MultiPolygon[T]) MultiPolygon[T] {
+	return booleanOperation(m, polygon, func(in1, in2 bool) bool { return in1 && in2 })
+}
+
+// Difference creates a new MultiPolygon covering points of the current multi-polygon not in the given one.
+func (m MultiPolygon[T]) Difference(polygon MultiPolygon[T]) MultiPolygon[T] {
+	return booleanOperation(m, polygon, func(in1, in2 bool) bool { return in1 && !in2 })
+}
+
+// Xor creates a new MultiPolygon covering points in exactly one of the multi-polygons.
+func (m MultiPolygon[T]) Xor(polygon MultiPolygon[T]) MultiPolygon[T] {
+	return booleanOperation(m, polygon, func(in1, in2 bool) bool { return in1 != in2 })
+}
+
+// Translate creates a new MultiPolygon translated by the given vector.
+func (m MultiPolygon[T]) Translate(vector Vector[T]) MultiPolygon[T] {
+	polygons := make([]Polygon[T], len(m.Polygons))
+	for i, polygon := range m.Polygons {
+		polygons[i] = polygon.Translate(vector)
+	}
+
+	return MultiPolygon[T]{polygons}
+}
+
+// Area returns the area of rings with consistent winding (like boolean operations results).
+func (m MultiPolygon[T]) Area() float64 {
+	var area float64
+	for _, polygon := range m.Polygons {
+		area += polygon.SignedArea()
+	}
+
+	return math.Abs(area)
+}
+
+// Bounds returns the axis-aligned bounding rectangle.
+func (m MultiPolygon[T]) Bounds() Rectangle[T] {
+	rects := make([]Rectangle[T], 0, len(m.Polygons))
+	for _, polygon := range m.Polygons {
+		if !polygon.Empty() {
+			rects = append(rects, polygon.Bounds())
+		}
+	}
+
+	return RectUnion(rects...)
+}
+
+// Contains checks if the given point lies inside the multi-polygon (using even-odd rule) or on its boundary.
+func (m MultiPolygon[T]) Contains(point Point[T]) bool {
+	rings, p := multiPolygonRings(m), point.Float()
+	for _, ring := range rings {
+		if polygonOnBoundary(ring, p) {
+			return true
+		}
+	}
+
+	return evenOddContains(rings, p)
+}
+
+// Equal checks if two multi-polygons have the same polygons.
+func (m MultiPolygon[T]) Equal(polygon MultiPolygon[T]) bool {
+	return slices.EqualFunc(m.Polygons, polygon.Polygons, Polygon[T].Equal)
+}
+
+// Empty checks if number of polygons is zero.
+func (m MultiPolygon[T]) Empty() bool {
+	return len(m.Polygons) == 0
+}
+
+// String returns a string representation of the MultiPolygon.
+func (m MultiPolygon[T]) String() string {
+	polygons := make([]string, len(m.Polygons))
+	for i, polygon := range m.Polygons {
+		polygons[i] = polygon.String()
+	}
+
+	return fmt.Sprintf("MPol(%s)", strings.Join(polygons, ", "))
+}
+
+// booleanOperation creates a new MultiPolygon from points for which operation on (even-odd) membership in both multi-polygons is true.
+func booleanOperation[T Number](polygon1, polygon2 MultiPolygon[T], operation func(in1, in2 bool) bool) MultiPolygon[T] {
+	var vertices vertexTable
+	rings1, rings2 := vertices.snapRings(multiPolygonRings(polygon1)), vertices.snapRings(multiPolygonRings(polygon2))
+
+	rings := traceBoundary(slices.Concat(rings1, rings2), func(point Point[float64]) bool {
+		return operation(evenOddContains(rings1, point), evenOddContains(rings2, point))
+	})
+
+	return multiPolygonFromRings[T](rings)
+}
+
+// multiPolygonRings returns float rings of the multi-polygon.
+func multiPolygonRings[T Number](polygon MultiPolygon[T]) [][]Point[float64] {
+	rings := make([][]Point[float64], 0, len(polygon.Polygons))
+	for _, p := range polygon.Polygons {
+		if len(p.Vertices) > 0 {
+			rings = append(rings, p.Float().Vertices)
+		}
+	}
+
+	return rings
+}
+
+// multiPolygonFromRings creates MultiPolygon from float rings, grouping each outer ring with its holes.
+func multiPolygonFromRings[T Number](rings [][]Point[float64]) MultiPolygon[T] {
+	var outers, holes [][]Point[float64]
+	for _, ring := range rings {
+		if signedArea(ring) > 0 {
+			outers = append(outers, ring)
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	// hole belongs to the smallest outer ring containing a point next to its first edge on the filled side
+	children := make([][][]Point[float64], len(outers))
+	for _, hole := range holes {
+		probe := boundaryProbe(hole[0], hole[1], 1)
+
+		parent := -1
+		for i, outer := range outers {
+			if crossingNumber(outer, probe)%2 == 1 && (parent < 0 || signedArea(outer) < signedArea(outers[parent])) {
+				parent = i
+			}
+		}
+
+		if parent >= 0 {
+			children[parent] = append(children[parent], hole)
+		}
+	}
+
+	var polygons []Polygon[T]
+	for i, outer := range outers {
+		for _, ring := range slices.Concat([][]Point[float64]{outer}, children[i]) {
+			if polygon, ok := ringPolygon[T](ring); ok {
+				polygons = append(polygons, polygon)
+			}
+		}
+	}
+
+	return MultiPolygon[T]{polygons}
+}
+
+// ringPolygon casts float ring to Polygon, removing vertices collapsed by rounding.
+func ringPolygon[T Number](ring []Point[float64]) (Polygon[T], bool) {
+	vertices := make([]Point[T], 0, len(ring))
+	for _, point := range ring {
+		vertex := pointCast[T](point)
+		if len(vertices) > 0 && vertices[len(vertices)-1].Equal(vertex) {
+			continue
+		}
+		vertices = append(vertices, vertex)
+	}
+
+	for len(vertices) > 1 && vertices[0].Equal(vertices[len(vertices)-1]) {
+		vertices = vertices[:len(vertices)-1]
+	}
+
+	polygon := Polygon[T]{vertices}
+
+	return polygon, len(vertices) >= 3 && polygon.SignedArea() != 0
+}
+
+// evenOddContains checks if the point lies inside rings using even-odd rule.
+func evenOddContains(rings [][]Point[float64], point Point[float64]) bool {
+	crossings := 0
+	for _, ring := range rings {
+		crossings += crossingNumber(ring, point)
+	}
+
+	return crossings%2 == 1
+}
+
+// arrangementEdge is an undirected edge of a planar arrangement.
+type arrangementEdge struct {
+	start, end Point[float64]
+}
+
+// vertexTable is a set of vertices more than Delta apart, points closer than that are snapped to the same vertex.
+type vertexTable []Point[float64]
+
+// snap returns the vertex nearly equal to the point, the point is added as a new vertex if there is none.
+func (t *vertexTable) snap(point Point[float64]) Point[float64] {
+	for _, vertex := range *t {
+		if vertex.Equal(point) {
+			return vertex
+		}
+	}
+	*t = append(*t, point)
+
+	return point
+}
+
+// snapRings creates new rings with all vertices snapped to the table.
+func (t *vertexTable) snapRings(rings [][]Point[float64]) [][]Point[float64] {
+	snapped := make([][]Point[float64], len(rings))
+	for i, ring := range rings {
+		snapped[i] = make([]Point[float64], len(ring))
+		for j, point := range ring {
+			snapped[i][j] = t.snap(point)
+		}
+	}
+
+	return snapped
+}
+
+// traceBoundary returns rings bounding points for which inside is true.
+// Vertices of rings are expected to be snapped to a vertexTable, so nearly equal vertices are identical.
+// Edges of rings are split at their intersections, each piece separating inside points from outside ones is kept
+// and oriented with inside on the left, so outer rings have positive and holes negative signed area.
+// It panics if the boundary pieces cannot be connected into closed rings.
+func traceBoundary(rings [][]Point[float64], inside func(point Point[float64]) bool) [][]Point[float64] {
+	var boundary []arrangementEdge
+	for _, edge := range splitEdges(rings) {
+		left, right := inside(boundaryProbe(edge.start, edge.end, 1)), inside(boundaryProbe(edge.start, edge.end, -1))
+
+		switch {
+		case left && !right:
+			boundary = append(boundary, edge)
+		case right && !left:
+			boundary = append(boundary, arrangementEdge{edge.end, edge.start})
+		}
+	}
+
+	return stitchRings(boundary)
+}
+
+// boundaryProbe returns a point next to the edge midpoint on its left (side 1) or right (side -1).
+func boundaryProbe(start, end Point[float64], side float64) Point[float64] {
+	direction := end.Subtract(start)
+	normal := Vector[float64]{-direction.Y, direction.X}
+
+	return start.Midpoint(end).Add(normal.Multiply(side * probeDistance))
+}
+
+// probeDistance is the distance of classification probes from edges relative to the edge length.
+const probeDistance = 1e-6
+
+// splitEdges returns unique non-degenerate pieces of ring edges split at all their intersections.
+// Split points are snapped to ring vertices and to each other, so pieces meet at exactly equal points.
+func splitEdges(rings [][]Point[float64]) []arrangementEdge {
+	var vertices vertexTable
+	var edges []arrangementEdge
+	for _, ring := range rings {
+		for i, start := range ring {
+			vertices.snap(start)
+			if end := ring[(i+1)%len(ring)]; start != end {
+				edges = append(edges, arrangementEdge{start, end})
+			}
+		}
+	}
+
+	// split points of each edge, starting with its endpoints
+	splits := make([][]Point[float64], len(edges))
+	for i, edge := range edges {
+		splits[i] = []Point[float64]{edge.start, edge.end}
+	}
+
+	for i := range edges {
+		for j := i + 1; j < len(edges); j++ {
+			for _, split := range edgeSplits(edges[i], edges[j]) {
+				split = vertices.snap(split)
+				splits[i] = append(splits[i], split)
+				splits[j] = append(splits[j], split)
+			}
+		}
+	}
+
+	seen := make(map[arrangementEdge]bool)
+	var pieces []arrangementEdge
+	for i, edge := range edges {
+		direction := edge.end.Subtract(edge.start)
+		points := splits[i]
+		slices.SortFunc(points, func(p1, p2 Point[float64]) int {
+			return cmp.Compare(p1.Subtract(edge.start).Dot(direction), p2.Subtract(edge.start).Dot(direction))
+		})
+		points = slices.Compact(points)
+
+		for k := 0; k+1 < len(points); k++ {
+			piece := arrangementEdge{points[k], points[k+1]}
+			key := piece
+			if cmp.Or(cmp.Compare(key.start.X, key.end.X), cmp.Compare(key.start.Y, key.end.Y)) > 0 {
+				key = arrangementEdge{key.end, key.start}
+			}
+
+			if !seen[key] {
+				seen[key] = true
+				pieces = append(pieces, piece)
+			}
+		}
+	}
+
+	return pieces
+}
+
+// edgeSplits returns points where edges intersect, touch or overlap, endpoints within tolerance are used exactly.
+func edgeSplits(edge1, edge2 arrangementEdge) []Point[float64] {
+	if !edgeBoundsOverlap(edge1, edge2) {
+		return nil
+	}
+
+	direction1, direction2 := edge1.end.Subtract(edge1.start), edge2.end.Subtract(edge2.start)
+	offset := edge2.start.Subtract(edge1.start)
+	denominator := direction1.Cross(direction2)
+
+	if math.Abs(denominator) > splitTolerance*direction1.Length()*direction2.Length() {
+		t := offset.Cross(direction2) / denominator
+		u := offset.Cross(direction1) / denominator
+		if t < -splitTolerance || t > 1+splitTolerance || u < -splitTolerance || u > 1+splitTolerance {
+			return nil
+		}
+
+		switch {
+		case math.Abs(t) <= splitTolerance:
+			return []Point[float64]{edge1.start}
+		case math.Abs(t-1) <= splitTolerance:
+			return []Point[float64]{edge1.end}
+		case math.Abs(u) <= splitTolerance:
+			return []Point[float64]{edge2.start}
+		case math.Abs(u-1) <= splitTolerance:
+			return []Point[float64]{edge2.end}
+		default:
+			return []Point[float64]{edge1.start.Add(direction1.Multiply(t))}
+		}
+	}
+
+	// parallel edges split each other only when collinear
+	if math.Abs(offset.Cross(direction1)) > splitTolerance*direction1.LengthSquared() {
+		return nil
+	}
+
+	var points []Point[float64]
+	for _, point := range []Point[float64]{edge2.start, edge2.end} {
+		if t := point.Subtract(edge1.start).Dot(direction1) / direction1.LengthSquared(); t > 0 && t < 1 {
+			points = append(points, point)
+		}
+	}
+	for _, point := range []Point[float64]{edge1.start, edge1.end} {
+		if u := point.Subtract(edge2.start).Dot(direction2) / direction2.LengthSquared(); u > 0 && u < 1 {
+			points = append(points, point)
+		}
+	}
+
+	return points
+}
+
+// splitTolerance is the relative tolerance of edge intersection parameters.
+const splitTolerance = 1e-10
+
+// edgeBoundsOverlap checks if bounding boxes of the edges overlap.
+func edgeBoundsOverlap(edge1, edge2 arrangementEdge) bool {
+	return min(edge1.start.X, edge1.end.X) <= max(edge2.start.X, edge2.end.X) &&
+		min(edge2.start.X, edge2.end.X) <= max(edge1.start.X, edge1.end.X) &&
+		min(edge1.start.Y, edge1.end.Y) <= max(edge2.start.Y, edge2.end.Y) &&
+		min(edge2.start.Y, edge2.end.Y) <= max(edge1.start.Y, edge1.end.Y)
+}
+
+// stitchRings connects directed edges into closed rings, it panics if some chain of edges is not closed.
+// At vertices with multiple outgoing edges, the one turning most to the right is followed, so touching rings are separated.
+func stitchRings(edges []arrangementEdge) [][]Point[float64] {
+	outgoing := make(map[Point[float64]][]int)
+	for i, edge := range edges {
+		outgoing[edge.start] = append(outgoing[edge.start], i)
+	}
+
+	used := make([]bool, len(edges))
+	var rings [][]Point[float64]
+	for first := range edges {
+		if used[first] {
+			continue
+		}
+
+		ring := []Point[float64]{edges[first].start}
+		used[first] = true
+		current := first
+		for edges[current].end != ring[0] {
+			back := edges[current].start.Subtract(edges[current].end)
+
+			next, nextAngle := -1, math.Inf(1)
+			for _, candidate := range outgoing[edges[current].end] {
+				if used[candidate] {
+					continue
+				}
+
+				angle := back.Angle() - edges[candidate].end.Subtract(edges[candidate].start).Angle()
+				if angle <= 0 {
+					angle += 2 * math.Pi
+				}
+				if angle < nextAngle {
+					next, nextAngle = candidate, angle
+				}
+			}
+
+			if next < 0 {
+				panic(fmt.Sprintf("geom: boundary chain from %v is not closed at %v", ring[0], edges[current].end))
+			}
+
+			ring = append(ring, edges[next].start)
+			used[next] = true
+			current = next
+		}
+
+		if ring = removeCollinear(ring); len(ring) >= 3 {
+			rings = append(rings, ring)
+		}
+	}
+
+	return rings
+}
+
+// removeCollinear removes ring vertices lying on the line through their neighbours.
+func removeCollinear(ring []Point[float64]) []Point[float64] {
+	for changed := true; changed && len(ring) >= 3; {
+		changed = false
+		for i := 0; i < len(ring) && len(ring) >= 3; i++ {
+			prev, next := ring[(i+len(ring)-1)%len(ring)], ring[(i+1)%len(ring)]
+			if orientation(prev, ring[i], next) == 0 {
+				ring = slices.Delete(ring, i, i+1)
+				changed = true
+				i--
+			}
+		}
+	}
+
+	return ring
+}
@@ -0,0 +1,162 @@
+package geom
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+var (
+	squareA = RectFromMinMax(Pt(0, 0), Pt(4, 4)).Polygon()
+	squareB = RectFromMinMax(Pt(2, 2), Pt(6, 6)).Polygon()
+)
+
+// assertMultiPolygon checks area and winding of rings.
+func assertMultiPolygon[T Number](t *testing.T, m MultiPolygon[T], outers, holes int, area float64) {
+	t.Helper()
+
+	o, h := 0, 0
+	for _, polygon := range m.Polygons {
+		if polygon.IsClockwise() {
+			o++
+		} else {
+			h++
+		}
+	}
+
+	assert.Equal(t, o, outers, "outer rings")
+	assert.Equal(t, h, holes, "holes")
+	assert.EqualDelta(t, m.Area(), area, 1e-6)
+}
+
+func TestPolygon_Union(t *testing.T) {
+	union := squareA.Union(squareB)
+	assertMultiPolygon(t, union, 1, 0, 28)
+	AssertPolygon(t, union.Polygons[0], []Point[int]{Pt(0, 4), Pt(0, 0), Pt(4, 0), Pt(4, 2), Pt(6, 2), Pt(6, 6), Pt(2, 6), Pt(2, 4)})
+
+	// disjoint
+	assertMultiPolygon(t, squareA.Union(squareA.Translate(Vec(10, 0))), 2, 0, 32)
+	// touching at an edge is merged
+	assertMultiPolygon(t, squareA.Union(squareA.Translate(Vec(4, 0))), 1, 0, 32)
+	// touching at a vertex stays separate
+	assertMultiPolygon(t, squareA.Union(squareA.Translate(Vec(4, 4))), 2, 0, 32)
+	// ring around creates a hole
+	frame := MultiPol(RectFromMinMax(Pt(0, 0), Pt(10, 10)).Polygon(), RectFromMinMax(Pt(2, 2), Pt(8, 8)).Polygon())
+	assertMultiPolygon(t, frame.Union(MultiPol(RectFromMinMax(Pt(4, -2), Pt(6, 4)).Polygon())), 1, 1, 64+4+4)
+	// same polygon
+	assertMultiPolygon(t, squareA.Union(squareA), 1, 0, 16)
+}
+
+func TestPolygon_Intersect(t *testing.T) {
+	intersection := squareA.Intersect(squareB)
+	assertMultiPolygon(t, intersection, 1, 0, 4)
+	AssertPolygon(t, intersection.Polygons[0], []Point[int]{Pt(4, 4), Pt(2, 4), Pt(2, 2), Pt(4, 2)})
+
+	assert.True(t, squareA.Intersect(squareA.Translate(Vec(10, 0))).Empty())
+	assert.True(t, squareA.Intersect(squareA.Translate(Vec(4, 0))).Empty())
+
+	// concave U-shape intersected with a bar results in two pieces
+	shape := Pol([]Point[int]{Pt(0, 0), Pt(2, 0), Pt(2, 4), Pt(4, 4), Pt(4, 0), Pt(6, 0), Pt(6, 6), Pt(0, 6)})
+	assertMultiPolygon(t, shape.Intersect(RectFromMinMax(Pt(-1, 1), Pt(7, 3)).Polygon()), 2, 0, 8)
+}
+
+func TestPolygon_Difference(t *testing.T) {
+	assertMultiPolygon(t, squareA.Difference(squareB), 1, 0, 12)
+	assertMultiPolygon(t, squareB.Difference(squareA), 1, 0, 12)
+	assert.True(t, squareA.Difference(squareA).Empty())
+
+	// carving a hole
+	carved := RectFromMinMax(Pt(0, 0), Pt(10, 10)).Polygon().Difference(RectFromMinMax(Pt(3, 3), Pt(6, 6)).Polygon())
+	assertMultiPolygon(t, carved, 1, 1, 91)
+	assert.True(t, carved.Polygons[0].IsClockwise())
+	assert.False(t, carved.Contains(Pt(4, 4)))
+	assert.True(t, carved.Contains(Pt(1, 1)))
+	assert.True(t, carved.Contains(Pt(3, 4)))
+
+	// splitting in two
+	assertMultiPolygon(t, squareA.Difference(RectFromMinMax(Pt(1, -1), Pt(2, 5)).Polygon()), 2, 0, 12)
+}
+
+func TestPolygon_Xor(t *testing.T) {
+	xor := squareA.Xor(squareB)
+	assertMultiPolygon(t, xor, 2, 0, 24)
+
+	assert.True(t, squareA.Xor(squareA).Empty())
+}
+
+func TestPolygon_BooleanFloat(t *testing.T) {
+	triangle := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(3.0, 0.0), Pt(0.0, 3.0)})
+	square := RectFromMinMax(Pt(1.0, -1.0), Pt(2.0, 4.0)).Polygon()
+
+	assertMultiPolygon(t, triangle.Intersect(square), 1, 0, 1.5)
+	assertMultiPolygon(t, triangle.Difference(square), 2, 0, 3)
+	assertMultiPolygon(t, triangle.Union(square), 1, 0, 8)
+}
+
+func TestPolygon_BooleanNearlyCoincident(t *testing.T) {
+	triangle1 := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(4.0, 0.0), Pt(2.0, 3.0)})
+	triangle2 := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(2.0, 3.0000001), Pt(-2.0, 3.0)})
+
+	assertMultiPolygon(t, triangle1.Union(triangle2), 1, 0, 12)
+	assertMultiPolygon(t, triangle1.Intersect(triangle2), 0, 0, 0)
+	assertMultiPolygon(t, triangle1.Xor(triangle2), 1, 0, 12)
+
+	square := RectFromMinMax(Pt(0.0, 0.0), Pt(4.0, 4.0)).Polygon()
+	shifted := square.Translate(Vec(1e-12, 1e-12))
+
+	assertMultiPolygon(t, square.Union(shifted), 1, 0, 16)
+	assertMultiPolygon(t, square.Intersect(shifted), 1, 0, 16)
+	assertMultiPolygon(t, square.Difference(shifted), 0, 0, 0)
+}
+
+func TestPolygon_BooleanRandom(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	randomStar := func() Polygon[float64] {
+		n := 3 + random.IntN(12)
+		center := Pt(random.Float64()*20, random.Float64()*20)
+		vertices := make([]Point[float64], n)
+		for i := range vertices {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			vertices[i] = center.Add(Vec(math.Cos(angle), math.Sin(angle)).Multiply(5 + random.Float64()*10))
+		}
+
+		return Pol(vertices)
+	}
+
+	for range 200 {
+		polygon1, polygon2 := randomStar(), randomStar()
+		if random.IntN(2) == 0 {
+			polygon2 = polygon2.Reverse()
+		}
+
+		union, intersection := polygon1.Union(polygon2).Area(), polygon1.Intersect(polygon2).Area()
+		difference1, difference2 := polygon1.Difference(polygon2).Area(), polygon2.Difference(polygon1).Area()
+
+		assert.EqualDelta(t, union+intersection, polygon1.Area()+polygon2.Area(), 1e-6)
+		assert.EqualDelta(t, difference1+intersection, polygon1.Area(), 1e-6)
+		assert.EqualDelta(t, difference2+intersection, polygon2.Area(), 1e-6)
+		assert.EqualDelta(t, polygon1.Xor(polygon2).Area(), difference1+difference2, 1e-6)
+
+		// membership by sampling
+		for range 20 {
+			point := Pt(random.Float64()*40-10, random.Float64()*40-10)
+			assert.Equal(t, polygon1.Intersect(polygon2).Contains(point), polygon1.Contains(point) && polygon2.Contains(point))
+		}
+	}
+}
+
+func TestMultiPolygon_Bounds(t *testing.T) {
+	AssertRect(t, MultiPol(squareA, squareB).Bounds(), 3, 3, 6, 6)
+	AssertRect(t, MultiPolygon[int]{}.Bounds(), 0, 0, 0, 0)
+}
+
+func TestMultiPolygon_Translate(t *testing.T) {
+	assert.True(t, MultiPol(squareA).Translate(Vec(2, 2)).Equal(MultiPol(squareB)))
+}
+
+func TestMultiPolygon_String(t *testing.T) {
+	assert.Equal(t, MultiPol(squareA).String(), "MPol("+squareA.String()+")")
+}
@@ -0,0 +1,351 @@
+// Package bvh provides a dynamic bounding volume hierarchy (AABB tree) for broad-phase collision detection.
+package bvh
+
+import (
+	"iter"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Tree is a dynamic AABB tree of items keyed by their rectangle bounds.
+// Leaves store bounds fattened by margin on each side, so items moving within them do not need to be reinserted.
+// Tree is kept balanced with rotations, so its height stays logarithmic.
+type Tree[V comparable] struct {
+	root   *node[V]
+	leaves map[V]*node[V]
+	margin float64
+}
+
+// node is a tree node, leaves hold items and branches always have both children.
+type node[V comparable] struct {
+	// bounds are fattened item bounds for leaves, or union of children bounds for branches.
+	bounds geom.Rectangle[float64]
+	// item and its exact bounds for leaves.
+	item       V
+	itemBounds geom.Rectangle[float64]
+
+	parent, left, right *node[V]
+	height              int
+}
+
+// New creates an empty Tree with the given bounds margin on each side.
+// Negative margin is replaced by 0.
+func New[V comparable](margin float64) *Tree[V] {
+	return &Tree[V]{
+		leaves: make(map[V]*node[V]),
+		margin: max(margin, 0),
+	}
+}
+
+// Insert adds the item with the given bounds, already present item is moved to new bounds.
+func (t *Tree[V]) Insert(item V, bounds geom.Rectangle[float64]) {
+	if t.Update(item, bounds) {
+		return
+	}
+
+	leaf := &node[V]{bounds: bounds.Grow(2 * t.margin), item: item, itemBounds: bounds}
+	t.leaves[item] = leaf
+	t.insertLeaf(leaf)
+}
+
+// Remove removes the item, returns false if item is not present.
+func (t *Tree[V]) Remove(item V) bool {
+	leaf, ok := t.leaves[item]
+	if !ok {
+		return false
+	}
+
+	delete(t.leaves, item)
+	t.removeLeaf(leaf)
+
+	return true
+}
+
+// Update moves the item to new bounds, item is reinserted only if the bounds escape its fattened bounds.
+// It returns false if item is not present.
+func (t *Tree[V]) Update(item V, bounds geom.Rectangle[float64]) bool {
+	leaf, ok := t.leaves[item]
+	if !ok {
+		return false
+	}
+
+	leaf.itemBounds = bounds
+	if containsRect(leaf.bounds, bounds) {
+		return true
+	}
+
+	t.removeLeaf(leaf)
+	leaf.bounds = bounds.Grow(2 * t.margin)
+	t.insertLeaf(leaf)
+
+	return true
+}
+
+// Bounds returns exact bounds of the item.
+func (t *Tree[V]) Bounds(item V) (geom.Rectangle[float64], bool) {
+	leaf, ok := t.leaves[item]
+	if !ok {
+		return geom.Rectangle[float64]{}, false
+	}
+
+	return leaf.itemBounds, true
+}
+
+// Len returns number of items.
+func (t *Tree[V]) Len() int {
+	return len(t.leaves)
+}
+
+// Height returns height of the tree, zero for empty tree or single leaf.
+func (t *Tree[V]) Height() int {
+	if t.root == nil {
+		return 0
+	}
+
+	return t.root.height
+}
+
+// Clear removes all items.
+func (t *Tree[V]) Clear() {
+	t.root = nil
+	clear(t.leaves)
+}
+
+// QueryRect returns an iterator over items whose bounds collide with the given rectangle.
+func (t *Tree[V]) QueryRect(rect geom.Rectangle[float64]) iter.Seq[V] {
+	return t.query(func(bounds geom.Rectangle[float64]) bool {
+		return geom.CollisionRectangles(bounds, rect)
+	})
+}
+
+// QueryRay returns an iterator over items whose bounds are hit by the ray within maximum time.
+func (t *Tree[V]) QueryRay(ray geom.Ray[float64], maxTime float64) iter.Seq[V] {
+	return t.query(func(bounds geom.Rectangle[float64]) bool {
+		hit, ok := ray.CastRectangle(bounds)
+
+		return ok && hit.Time <= maxTime
+	})
+}
+
+// Pairs returns an iterator over all pairs of items with colliding bounds, each pair is reported once.
+func (t *Tree[V]) Pairs() iter.Seq2[V, V] {
+	return func(yield func(V, V) bool) {
+		if t.root != nil {
+			pairs(t.root, yield)
+		}
+	}
+}
+
+// query returns an iterator over items whose exact bounds pass the test, test must pass for any rectangle enclosing passing bounds.
+func (t *Tree[V]) query(test func(bounds geom.Rectangle[float64]) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if t.root == nil {
+			return
+		}
+
+		stack := []*node[V]{t.root}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !test(n.bounds) {
+				continue
+			}
+
+			if n.isLeaf() {
+				if test(n.itemBounds) && !yield(n.item) {
+					return
+				}
+			} else {
+				stack = append(stack, n.left, n.right)
+			}
+		}
+	}
+}
+
+// insertLeaf inserts the leaf next to the sibling with the lowest perimeter cost and rebalances ancestors.
+func (t *Tree[V]) insertLeaf(leaf *node[V]) {
+	if t.root == nil {
+		t.root = leaf
+		leaf.parent = nil
+		return
+	}
+
+	sibling := t.root
+	for !sibling.isLeaf() {
+		combined := sibling.bounds.Union(leaf.bounds).Perimeter()
+
+		// cost of creating a new parent for this node and the new leaf
+		cost := 2 * combined
+		// minimum cost of pushing the leaf further down the tree
+		inheritance := 2 * (combined - sibling.bounds.Perimeter())
+
+		leftCost, rightCost := descendCost(sibling.left, leaf, inheritance), descendCost(sibling.right, leaf, inheritance)
+		if cost < leftCost && cost < rightCost {
+			break
+		}
+
+		if leftCost < rightCost {
+			sibling = sibling.left
+		} else {
+			sibling = sibling.right
+		}
+	}
+
+	parent := &node[V]{parent: sibling.parent, left: sibling, right: leaf}
+	if sibling.parent == nil {
+		t.root = parent
+	} else {
+		sibling.parent.replaceChild(sibling, parent)
+	}
+	sibling.parent, leaf.parent = parent, parent
+
+	t.refit(parent)
+}
+
+// removeLeaf removes the leaf, replaces its parent with the sibling and rebalances ancestors.
+func (t *Tree[V]) removeLeaf(leaf *node[V]) {
+	if leaf == t.root {
+		t.root = nil
+		return
+	}
+
+	parent := leaf.parent
+	sibling := parent.left
+	if sibling == leaf {
+		sibling = parent.right
+	}
+
+	grandparent := parent.parent
+	sibling.parent = grandparent
+	leaf.parent = nil
+
+	if grandparent == nil {
+		t.root = sibling
+		return
+	}
+
+	grandparent.replaceChild(parent, sibling)
+	t.refit(grandparent)
+}
+
+// refit walks from the node to the root, balancing nodes and updating their bounds and heights.
+func (t *Tree[V]) refit(n *node[V]) {
+	for n != nil {
+		n = t.balance(n)
+		n.update()
+		n = n.parent
+	}
+}
+
+// balance performs left or right rotation if node is imbalanced, returns node at its position.
+func (t *Tree[V]) balance(a *node[V]) *node[V] {
+	if a.isLeaf() || a.height < 2 {
+		return a
+	}
+
+	b, c := a.left, a.right
+	switch balance := c.height - b.height; {
+	case balance > 1:
+		t.rotate(a, c, false)
+		return c
+	case balance < -1:
+		t.rotate(a, b, true)
+		return b
+	default:
+		return a
+	}
+}
+
+// rotate promotes child of node a in place of a, a takes the child shorter subtree.
+func (t *Tree[V]) rotate(a, child *node[V], left bool) {
+	f, g := child.left, child.right
+
+	child.left = a
+	child.parent = a.parent
+	a.parent = child
+
+	if child.parent == nil {
+		t.root = child
+	} else {
+		child.parent.replaceChild(a, child)
+	}
+
+	// keep taller grandchild in promoted child, move shorter one to a
+	if f.height > g.height {
+		f, g = g, f
+	}
+	child.right = g
+	if left {
+		a.left = f
+	} else {
+		a.right = f
+	}
+	f.parent = a
+
+	a.update()
+	child.update()
+}
+
+// pairs yields colliding item pairs within the subtree.
+func pairs[V comparable](n *node[V], yield func(V, V) bool) bool {
+	if n.isLeaf() {
+		return true
+	}
+
+	return pairs(n.left, yield) && pairs(n.right, yield) && crossPairs(n.left, n.right, yield)
+}
+
+// crossPairs yields colliding item pairs between two subtrees.
+func crossPairs[V comparable](a, b *node[V], yield func(V, V) bool) bool {
+	if !geom.CollisionRectangles(a.bounds, b.bounds) {
+		return true
+	}
+
+	switch {
+	case a.isLeaf() && b.isLeaf():
+		return !geom.CollisionRectangles(a.itemBounds, b.itemBounds) || yield(a.item, b.item)
+	case b.isLeaf() || (!a.isLeaf() && a.height >= b.height):
+		return crossPairs(a.left, b, yield) && crossPairs(a.right, b, yield)
+	default:
+		return crossPairs(a, b.left, yield) && crossPairs(a, b.right, yield)
+	}
+}
+
+// descendCost returns cost of inserting the leaf into the child subtree.
+func descendCost[V comparable](child, leaf *node[V], inheritance float64) float64 {
+	perimeter := child.bounds.Union(leaf.bounds).Perimeter()
+	if child.isLeaf() {
+		return perimeter + inheritance
+	}
+
+	return perimeter - child.bounds.Perimeter() + inheritance
+}
+
+// isLeaf checks if node holds an item.
+func (n *node[V]) isLeaf() bool {
+	return n.left == nil
+}
+
+// update recomputes branch bounds and height from its children.
+func (n *node[V]) update() {
+	n.bounds = n.left.bounds.Union(n.right.bounds)
+	n.height = 1 + max(n.left.height, n.right.height)
+}
+
+// replaceChild replaces the old child with the new one.
+func (n *node[V]) replaceChild(old, new *node[V]) {
+	if n.left == old {
+		n.left = new
+	} else {
+		n.right = new
+	}
+}
+
+// containsRect checks if the outer rectangle fully contains the inner rectangle.
+func containsRect(outer, inner geom.Rectangle[float64]) bool {
+	outerMin, outerMax := outer.Min(), outer.Max()
+	innerMin, innerMax := inner.Min(), inner.Max()
+
+	return outerMin.X <= innerMin.X && outerMin.Y <= innerMin.Y && innerMax.X <= outerMax.X && innerMax.Y <= outerMax.Y
+}
@@ -0,0 +1,164 @@
+package bvh
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+var items = []geom.Rectangle[float64]{
+	geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(10.0, 10.0)),
+	geom.RectFromMinMax(geom.Pt(5.0, 5.0), geom.Pt(15.0, 15.0)),
+	geom.RectFromMinMax(geom.Pt(40.0, 0.0), geom.Pt(50.0, 10.0)),
+	geom.RectFromMinMax(geom.Pt(40.0, 40.0), geom.Pt(50.0, 50.0)),
+}
+
+func TestNew(t *testing.T) {
+	tree := New[int](1)
+	assert.Equal(t, tree.Len(), 0)
+	assert.Equal(t, tree.Height(), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(-100.0, -100.0), geom.Pt(100.0, 100.0))))), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryRay(geom.Ry(geom.Pt(0.0, 0.0), geom.Vec(1.0, 0.0)), 100))), 0)
+	assert.False(t, tree.Remove(0))
+
+	for range tree.Pairs() {
+		assert.Fail(t, "empty tree has no pairs")
+	}
+
+	// negative margin is clamped, so leaves are never smaller than their items
+	tree = New[int](-1)
+	tree.Insert(0, items[0])
+	assert.True(t, tree.root.bounds.Equal(items[0]))
+	assert.Equal(t, slices.Collect(tree.QueryRect(geom.Rect(geom.Pt(0.0, 0.0), geom.Sz(0.0, 0.0)))), []int{0})
+}
+
+func TestTree_Insert(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.Equal(t, tree.Len(), 4)
+	assert.Equal(t, tree.Height(), 2)
+	assert.True(t, tree.root.bounds.Equal(geom.RectFromMinMax(geom.Pt(-1.0, -1.0), geom.Pt(51.0, 51.0))))
+
+	bounds, ok := tree.Bounds(1)
+	assert.True(t, ok)
+	assert.True(t, bounds.Equal(geom.RectFromMinMax(geom.Pt(5.0, 5.0), geom.Pt(15.0, 15.0))))
+}
+
+func TestTree_Remove(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.True(t, tree.Remove(0))
+	assert.False(t, tree.Remove(0))
+	assert.True(t, tree.Remove(2))
+	assert.Equal(t, tree.Len(), 2)
+	assert.Equal(t, tree.Height(), 1)
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(50.0, 50.0)))), []int{1, 3})
+
+	tree.Clear()
+	assert.Equal(t, tree.Len(), 0)
+	assert.Equal(t, tree.Height(), 0)
+}
+
+func TestTree_Update(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	// small move within fattened bounds keeps the leaf
+	leaf := tree.leaves[0]
+	fat := leaf.bounds
+	assert.True(t, tree.Update(0, geom.RectFromMinMax(geom.Pt(0.5, 0.5), geom.Pt(10.5, 10.5))))
+	assert.True(t, leaf.bounds.Equal(fat))
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(-1.0, -1.0), geom.Pt(0.0, 0.0))))), 0)
+
+	// escaping move reinserts the leaf
+	assert.True(t, tree.Update(0, geom.RectFromMinMax(geom.Pt(60.0, 60.0), geom.Pt(70.0, 70.0))))
+	assert.False(t, leaf.bounds.Equal(fat))
+	assert.Equal(t, slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(65.0, 65.0), geom.Pt(66.0, 66.0)))), []int{0})
+
+	assert.False(t, tree.Update(9, geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(1.0, 1.0))))
+}
+
+func TestTree_QueryRect(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(8.0, 8.0), geom.Pt(42.0, 42.0)))), []int{0, 1, 2, 3})
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(12.0, 0.0), geom.Pt(20.0, 4.0)))), []int(nil))
+}
+
+func TestTree_QueryRay(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.Equal(t, slices.Sorted(tree.QueryRay(geom.Ry(geom.Pt(-10.0, 5.0), geom.Vec(1.0, 0.0)), 100)), []int{0, 1, 2})
+	assert.Equal(t, slices.Sorted(tree.QueryRay(geom.Ry(geom.Pt(-10.0, 5.0), geom.Vec(1.0, 0.0)), 12)), []int{0})
+	assert.Equal(t, slices.Sorted(tree.QueryRay(geom.Ry(geom.Pt(0.0, 20.0), geom.Vec(1.0, 1.0)), 100)), []int(nil))
+	assert.Equal(t, slices.Sorted(tree.QueryRay(geom.Ry(geom.Pt(0.0, 0.0), geom.Vec(1.0, 1.0)), 100)), []int{0, 1, 3})
+}
+
+func TestTree_Pairs(t *testing.T) {
+	tree := New[int](1)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+	tree.Insert(4, geom.RectFromMinMax(geom.Pt(45.0, 45.0), geom.Pt(55.0, 55.0)))
+
+	var pairs [][2]int
+	for item1, item2 := range tree.Pairs() {
+		pairs = append(pairs, [2]int{min(item1, item2), max(item1, item2)})
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int { return a[0] - b[0] })
+
+	assert.Equal(t, pairs, [][2]int{{0, 1}, {3, 4}})
+}
+
+func TestTree_PairsTouching(t *testing.T) {
+	tree := New[int](1)
+	tree.Insert(0, geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(10.0, 10.0)))
+	tree.Insert(1, geom.RectFromMinMax(geom.Pt(10.0, 0.0), geom.Pt(20.0, 10.0)))
+	// overlapping fattened bounds alone do not make a pair
+	tree.Insert(2, geom.RectFromMinMax(geom.Pt(21.0, 0.0), geom.Pt(30.0, 10.0)))
+	// zero-size item on the shared edge
+	tree.Insert(3, geom.Rect(geom.Pt(10.0, 5.0), geom.Sz(0.0, 0.0)))
+
+	var pairs [][2]int
+	for item1, item2 := range tree.Pairs() {
+		pairs = append(pairs, [2]int{min(item1, item2), max(item1, item2)})
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int { return cmp.Or(a[0]-b[0], a[1]-b[1]) })
+
+	assert.Equal(t, pairs, [][2]int{{0, 1}, {0, 3}, {1, 3}})
+
+	// removed item is not reported and can be inserted again
+	assert.True(t, tree.Remove(3))
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.Rect(geom.Pt(10.0, 5.0), geom.Sz(0.0, 0.0)))), []int{0, 1})
+	tree.Insert(3, geom.Rect(geom.Pt(25.0, 5.0), geom.Sz(0.0, 0.0)))
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.Rect(geom.Pt(25.0, 5.0), geom.Sz(0.0, 0.0)))), []int{2, 3})
+}
+
+func TestTree_Balance(t *testing.T) {
+	tree := New[int](0)
+	// items inserted in order would form a list without rotations
+	for i := range 64 {
+		tree.Insert(i, geom.RectFromMin(geom.Pt(float64(i)*10, 0.0), geom.Sz(5.0, 5.0)))
+	}
+
+	assert.Equal(t, tree.Len(), 64)
+	assert.True(t, tree.Height() <= 12, "tree is balanced")
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(200.0, 0.0), geom.Pt(225.0, 1.0)))), []int{20, 21, 22})
+}
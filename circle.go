@@ -21,6 +21,16 @@ func (c Circle[T]) Translate(vector Vector[T]) Circle[T] {
 	return Circle[T]{c.Center.Add(vector), c.Radius}
 }
 
+// TranslateShape creates a new Circle translated by the given vector as Shape.
+func (c Circle[T]) TranslateShape(vector Vector[T]) Shape[T] {
+	return c.Translate(vector)
+}
+
+// Centroid returns the center of the circle.
+func (c Circle[T]) Centroid() Point[T] {
+	return c.Center
+}
+
 // MoveTo creates a new Circle with the same radius and the center set to point.
 func (c Circle[T]) MoveTo(point Point[T]) Circle[T] {
 	return Circle[T]{point, c.Radius}
@@ -63,7 +73,24 @@ func (c Circle[T]) Diameter() T {
 
 // Bounds returns the axis-aligned bounding rectangle.
 func (c Circle[T]) Bounds() Rectangle[T] {
-	return Rectangle[T]{c.Center, Size[T]{c.Radius, c.Radius}}
+	return Rectangle[T]{c.Center, Size[T]{c.Diameter(), c.Diameter()}}
+}
+
+// ClosestPoint returns the point on the circle boundary nearest to the given point.
+func (c Circle[T]) ClosestPoint(point Point[T]) Point[T] {
+	center := c.Center.Float()
+
+	return pointCast[T](center.Add(point.Float().Subtract(center).Normalize().Multiply(float64(c.Radius))))
+}
+
+// SignedDistance returns distance from the circle boundary to the given point, negative inside the circle.
+func (c Circle[T]) SignedDistance(point Point[T]) float64 {
+	return c.Center.DistanceTo(point) - float64(c.Radius)
+}
+
+// Support returns the farthest point of the circle in the given direction.
+func (c Circle[T]) Support(direction Vector[float64]) Point[float64] {
+	return c.Center.Float().Add(direction.Normalize().Multiply(float64(c.Radius)))
 }
 
 // Equal checks for equal center and radius with given circle.
@@ -76,6 +103,11 @@ func (c Circle[T]) IsZero() bool {
 	return c.Center.IsZero() && Equal(c.Radius, 0)
 }
 
+// CastRay casts the given ray against the circle (same as Ray.CastCircle).
+func (c Circle[T]) CastRay(ray Ray[T]) (RayHit[T], bool) {
+	return ray.CastCircle(c)
+}
+
 // Contains checks if the given point lies inside the circle.
 func (c Circle[T]) Contains(point Point[T]) bool {
 	return c.Center.Subtract(point).Less(c.Radius)
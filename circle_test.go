@@ -64,8 +64,29 @@ func TestCircle_Diameter(t *testing.T) {
 }
 
 func TestCircle_Bounds(t *testing.T) {
-	AssertRect(t, circleInt.Bounds(), 1, 2, 10, 10)
-	AssertRect(t, circleFloat.Bounds(), 0.6, -0.25, 1.2, 1.2)
+	AssertRect(t, circleInt.Bounds(), 1, 2, 20, 20)
+	AssertRect(t, circleFloat.Bounds(), 0.6, -0.25, 2.4, 2.4)
+	assert.True(t, Circ(Pt(0.0, 0.0), 10.0).Bounds().Equal(RectFromMinMax(Pt(-10.0, -10.0), Pt(10.0, 10.0))))
+}
+
+func TestCircle_ClosestPoint(t *testing.T) {
+	AssertPoint(t, circleInt.ClosestPoint(Pt(21, 2)), 11, 2)
+	AssertPoint(t, circleInt.ClosestPoint(Pt(1, 0)), 1, -8)
+	AssertPoint(t, circleInt.ClosestPoint(Pt(1, 2)), 11, 2)
+	AssertPoint(t, Circ(Pt(0.0, 0.0), 2.0).ClosestPoint(Pt(3.0, 4.0)), 1.2, 1.6)
+}
+
+func TestCircle_SignedDistance(t *testing.T) {
+	assert.EqualDelta(t, circleInt.SignedDistance(Pt(21, 2)), 10.0, Delta)
+	assert.EqualDelta(t, circleInt.SignedDistance(Pt(1, 2)), -10.0, Delta)
+	assert.EqualDelta(t, circleInt.SignedDistance(Pt(7, 10)), 0.0, Delta)
+	assert.EqualDelta(t, circleFloat.SignedDistance(Pt(0.6, 0.25)), -0.7, Delta)
+}
+
+func TestCircle_Support(t *testing.T) {
+	AssertPoint(t, circleInt.Support(Vec(1.0, 0.0)), 11, 2)
+	AssertPoint(t, circleInt.Support(Vec(0.0, -5.0)), 1, -8)
+	AssertPoint(t, circleFloat.Support(Vec(1.0, 1.0)), 0.6+1.2*OneOverSqrt2, -0.25+1.2*OneOverSqrt2)
 }
 
 func TestCircle_Equal(t *testing.T) {
@@ -1,5 +1,9 @@
 package geom
 
+import (
+	"math"
+)
+
 // CollisionRectangles checks if the given rectangles collide.
 func CollisionRectangles[T Number](rect1 Rectangle[T], rect2 Rectangle[T]) bool {
 	min1, max1 := rect1.Min(), rect1.Max()
@@ -34,3 +38,355 @@ func CollisionRectangleCircle[T Number](rect Rectangle[T], circle Circle[T]) boo
 	// circle center is less than its size outside nearest border
 	return distance.Subtract(extends).Less(circle.Radius)
 }
+
+// CollisionPolygons checks if the given convex polygons collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the first polygon out of the second one.
+func CollisionPolygons[T Number](polygon1 Polygon[T], polygon2 Polygon[T]) (Vector[T], bool) {
+	normal, depth, ok := satPolygons(polygon1.Float().Vertices, polygon2.Float().Vertices)
+
+	return vectorCast[T](normal.Multiply(depth)), ok
+}
+
+// CollisionPolygonCircle checks if the given convex polygon and circle collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the polygon out of the circle.
+func CollisionPolygonCircle[T Number](polygon Polygon[T], circle Circle[T]) (Vector[T], bool) {
+	normal, depth, ok := satPolygonCircle(polygon.Float().Vertices, circle.Float())
+
+	return vectorCast[T](normal.Multiply(depth)), ok
+}
+
+// CollisionPolygonRectangle checks if the given convex polygon and rectangle collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the polygon out of the rectangle.
+func CollisionPolygonRectangle[T Number](polygon Polygon[T], rect Rectangle[T]) (Vector[T], bool) {
+	return CollisionPolygons(polygon, rect.Polygon())
+}
+
+// CollisionRegularPolygons checks if the given regular polygons collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the first polygon out of the second one.
+func CollisionRegularPolygons[T Number](polygon1 RegularPolygon[T], polygon2 RegularPolygon[T]) (Vector[T], bool) {
+	return CollisionPolygons(polygon1.Polygon(), polygon2.Polygon())
+}
+
+// CollisionRegularPolygonCircle checks if the given regular polygon and circle collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the polygon out of the circle.
+func CollisionRegularPolygonCircle[T Number](polygon RegularPolygon[T], circle Circle[T]) (Vector[T], bool) {
+	return CollisionPolygonCircle(polygon.Polygon(), circle)
+}
+
+// CollisionRegularPolygonRectangle checks if the given regular polygon and rectangle collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the polygon out of the rectangle.
+func CollisionRegularPolygonRectangle[T Number](polygon RegularPolygon[T], rect Rectangle[T]) (Vector[T], bool) {
+	return CollisionPolygons(polygon.Polygon(), rect.Polygon())
+}
+
+// CollisionRegularPolygonPolygon checks if the given regular polygon and convex polygon collide using Separating Axis Theorem.
+// It returns the minimum translation vector, which pushes the regular polygon out of the polygon.
+func CollisionRegularPolygonPolygon[T Number](regular RegularPolygon[T], polygon Polygon[T]) (Vector[T], bool) {
+	return CollisionPolygons(regular.Polygon(), polygon)
+}
+
+// Manifold is a contact information of two colliding shapes.
+type Manifold[T Number] struct {
+	// Normal is unit vector pointing from the first shape to the second one.
+	Normal Vector[float64]
+	// Contacts are (up to two) points of the deepest penetration.
+	Contacts []Point[T]
+	// Depth is penetration depth along the normal.
+	Depth float64
+}
+
+// ContactRectangles returns contact manifold of the given rectangles.
+func ContactRectangles[T Number](rect1 Rectangle[T], rect2 Rectangle[T]) (Manifold[T], bool) {
+	return ContactPolygons(rect1.Polygon(), rect2.Polygon())
+}
+
+// ContactCircles returns contact manifold of the given circles.
+func ContactCircles[T Number](circle1 Circle[T], circle2 Circle[T]) (Manifold[T], bool) {
+	if !CollisionCircles(circle1, circle2) {
+		return Manifold[T]{}, false
+	}
+
+	c1, c2 := circle1.Float(), circle2.Float()
+	distance := c2.Center.Subtract(c1.Center)
+	normal := distance.Normalize()
+
+	return Manifold[T]{
+		Normal:   normal,
+		Contacts: []Point[T]{pointCast[T](c2.Center.Add(normal.Multiply(-c2.Radius)))},
+		Depth:    c1.Radius + c2.Radius - distance.Length(),
+	}, true
+}
+
+// ContactRectangleCircle returns contact manifold of the given rectangle and circle.
+func ContactRectangleCircle[T Number](rect Rectangle[T], circle Circle[T]) (Manifold[T], bool) {
+	if !CollisionRectangleCircle(rect, circle) {
+		return Manifold[T]{}, false
+	}
+
+	r, c := rect.Float(), circle.Float()
+	closest := r.Clamp(c.Center)
+
+	// circle center is outside rectangle
+	if !closest.Equal(c.Center) {
+		distance := c.Center.Subtract(closest)
+		normal := distance.Normalize()
+
+		return Manifold[T]{
+			Normal:   normal,
+			Contacts: []Point[T]{pointCast[T](c.Center.Add(normal.Multiply(-c.Radius)))},
+			Depth:    c.Radius - distance.Length(),
+		}, true
+	}
+
+	// circle center is inside rectangle, push it out through the nearest side
+	offset := c.Center.Subtract(r.Center)
+	extends := r.Size.Scale(0.5).Vector()
+	gapX, gapY := extends.X-math.Abs(offset.X), extends.Y-math.Abs(offset.Y)
+
+	normal := Vector[float64]{math.Copysign(1, offset.X), 0}
+	depth := gapX
+	if gapY < gapX {
+		normal, depth = Vector[float64]{0, math.Copysign(1, offset.Y)}, gapY
+	}
+
+	return Manifold[T]{
+		Normal:   normal,
+		Contacts: []Point[T]{pointCast[T](c.Center.Add(normal.Multiply(-c.Radius)))},
+		Depth:    depth + c.Radius,
+	}, true
+}
+
+// ContactPolygons returns contact manifold of the given convex polygons.
+func ContactPolygons[T Number](polygon1 Polygon[T], polygon2 Polygon[T]) (Manifold[T], bool) {
+	vertices1, vertices2 := polygon1.Float().Vertices, polygon2.Float().Vertices
+
+	push, depth, ok := satPolygons(vertices1, vertices2)
+	if !ok {
+		return Manifold[T]{}, false
+	}
+
+	normal := push.Negate()
+	reference, incident := bestEdge(vertices1, normal), bestEdge(vertices2, normal.Negate())
+
+	// reference edge is the one most perpendicular to the normal
+	referenceNormal := normal
+	if math.Abs(incident.direction().Dot(normal)) < math.Abs(reference.direction().Dot(normal)) {
+		reference, incident = incident, reference
+		referenceNormal = normal.Negate()
+	}
+
+	// clip incident edge to the reference edge side planes
+	direction := reference.direction()
+	points := clipSegment(incident.start, incident.end, direction, direction.Dot(reference.start.Vector()))
+	if len(points) < 2 {
+		return Manifold[T]{normal, []Point[T]{pointCast[T](incident.deepest)}, depth}, true
+	}
+	points = clipSegment(points[0], points[1], direction.Negate(), -direction.Dot(reference.end.Vector()))
+	if len(points) < 2 {
+		return Manifold[T]{normal, []Point[T]{pointCast[T](incident.deepest)}, depth}, true
+	}
+
+	// keep only points behind the reference face
+	face := referenceNormal.Dot(reference.deepest.Vector())
+	contacts := make([]Point[T], 0, 2)
+	for _, point := range points {
+		if referenceNormal.Dot(point.Vector())-face <= Delta {
+			contacts = append(contacts, pointCast[T](point))
+		}
+	}
+	if len(contacts) == 0 {
+		contacts = append(contacts, pointCast[T](incident.deepest))
+	}
+
+	return Manifold[T]{normal, contacts, depth}, true
+}
+
+// ContactPolygonCircle returns contact manifold of the given convex polygon and circle.
+func ContactPolygonCircle[T Number](polygon Polygon[T], circle Circle[T]) (Manifold[T], bool) {
+	c := circle.Float()
+
+	push, depth, ok := satPolygonCircle(polygon.Float().Vertices, c)
+	if !ok {
+		return Manifold[T]{}, false
+	}
+
+	normal := push.Negate()
+
+	return Manifold[T]{
+		Normal:   normal,
+		Contacts: []Point[T]{pointCast[T](c.Center.Add(normal.Multiply(-c.Radius)))},
+		Depth:    depth,
+	}, true
+}
+
+// ContactPolygonRectangle returns contact manifold of the given convex polygon and rectangle.
+func ContactPolygonRectangle[T Number](polygon Polygon[T], rect Rectangle[T]) (Manifold[T], bool) {
+	return ContactPolygons(polygon, rect.Polygon())
+}
+
+// ContactRegularPolygons returns contact manifold of the given regular polygons.
+func ContactRegularPolygons[T Number](polygon1 RegularPolygon[T], polygon2 RegularPolygon[T]) (Manifold[T], bool) {
+	return ContactPolygons(polygon1.Polygon(), polygon2.Polygon())
+}
+
+// ContactRegularPolygonCircle returns contact manifold of the given regular polygon and circle.
+func ContactRegularPolygonCircle[T Number](polygon RegularPolygon[T], circle Circle[T]) (Manifold[T], bool) {
+	return ContactPolygonCircle(polygon.Polygon(), circle)
+}
+
+// ContactRegularPolygonRectangle returns contact manifold of the given regular polygon and rectangle.
+func ContactRegularPolygonRectangle[T Number](polygon RegularPolygon[T], rect Rectangle[T]) (Manifold[T], bool) {
+	return ContactPolygons(polygon.Polygon(), rect.Polygon())
+}
+
+// ContactRegularPolygonPolygon returns contact manifold of the given regular polygon and convex polygon.
+func ContactRegularPolygonPolygon[T Number](regular RegularPolygon[T], polygon Polygon[T]) (Manifold[T], bool) {
+	return ContactPolygons(regular.Polygon(), polygon)
+}
+
+// edge is a polygon edge with its vertex farthest along the searched direction.
+type edge struct {
+	start, end, deepest Point[float64]
+}
+
+// direction returns unit direction of the edge.
+func (e edge) direction() Vector[float64] {
+	return e.end.Subtract(e.start).Normalize()
+}
+
+// bestEdge returns the polygon edge most perpendicular to the direction, containing the farthest vertex.
+func bestEdge(vertices []Point[float64], direction Vector[float64]) edge {
+	index := 0
+	for i, vertex := range vertices {
+		if vertex.Vector().Dot(direction) > vertices[index].Vector().Dot(direction) {
+			index = i
+		}
+	}
+
+	n := len(vertices)
+	vertex, next, prev := vertices[index], vertices[(index+1)%n], vertices[(index+n-1)%n]
+
+	if math.Abs(next.Subtract(vertex).Normalize().Dot(direction)) <= math.Abs(vertex.Subtract(prev).Normalize().Dot(direction)) {
+		return edge{vertex, next, vertex}
+	}
+
+	return edge{prev, vertex, vertex}
+}
+
+// clipSegment returns parts of the segment, which projections onto the direction are at least offset.
+func clipSegment(start, end Point[float64], direction Vector[float64], offset float64) []Point[float64] {
+	points := make([]Point[float64], 0, 2)
+
+	distance1, distance2 := direction.Dot(start.Vector())-offset, direction.Dot(end.Vector())-offset
+	if distance1 >= 0 {
+		points = append(points, start)
+	}
+	if distance2 >= 0 {
+		points = append(points, end)
+	}
+	if distance1*distance2 < 0 {
+		points = append(points, start.Lerp(end, distance1/(distance1-distance2)))
+	}
+
+	return points
+}
+
+// satPolygons returns unit normal (pushing first polygon out of the second one) and penetration depth.
+func satPolygons(vertices1, vertices2 []Point[float64]) (Vector[float64], float64, bool) {
+	if len(vertices1) == 0 || len(vertices2) == 0 {
+		return Vector[float64]{}, 0, false
+	}
+
+	axes := append(polygonAxes(vertices1), polygonAxes(vertices2)...)
+
+	return separatingAxes(axes, func(axis Vector[float64]) (float64, float64, float64, float64) {
+		min1, max1 := projectVertices(vertices1, axis)
+		min2, max2 := projectVertices(vertices2, axis)
+
+		return min1, max1, min2, max2
+	})
+}
+
+// satPolygonCircle returns unit normal (pushing polygon out of the circle) and penetration depth.
+func satPolygonCircle(vertices []Point[float64], circle Circle[float64]) (Vector[float64], float64, bool) {
+	if len(vertices) == 0 {
+		return Vector[float64]{}, 0, false
+	}
+
+	// axis from the nearest vertex to circle center
+	nearest := vertices[0]
+	for _, vertex := range vertices[1:] {
+		if vertex.DistanceSquaredTo(circle.Center) < nearest.DistanceSquaredTo(circle.Center) {
+			nearest = vertex
+		}
+	}
+
+	axes := polygonAxes(vertices)
+	if !nearest.Equal(circle.Center) {
+		axes = append(axes, circle.Center.Subtract(nearest).Normalize())
+	}
+
+	return separatingAxes(axes, func(axis Vector[float64]) (float64, float64, float64, float64) {
+		min1, max1 := projectVertices(vertices, axis)
+		projection := circle.Center.Vector().Dot(axis)
+
+		return min1, max1, projection - circle.Radius, projection + circle.Radius
+	})
+}
+
+// separatingAxes finds the axis with the smallest overlap of two projections, or reports a separating axis.
+func separatingAxes(axes []Vector[float64], project func(axis Vector[float64]) (float64, float64, float64, float64)) (Vector[float64], float64, bool) {
+	depth := math.Inf(1)
+	var normal Vector[float64]
+
+	for _, axis := range axes {
+		min1, max1, min2, max2 := project(axis)
+		if max1 < min2 || max2 < min1 {
+			return Vector[float64]{}, 0, false
+		}
+
+		// push first projection to the nearest side of the second one
+		if overlap := max1 - min2; overlap < depth {
+			depth, normal = overlap, axis.Negate()
+		}
+		if overlap := max2 - min1; overlap < depth {
+			depth, normal = overlap, axis
+		}
+	}
+
+	return normal, depth, true
+}
+
+// polygonAxes returns unit normals of all non-degenerate polygon edges.
+func polygonAxes(vertices []Point[float64]) []Vector[float64] {
+	axes := make([]Vector[float64], 0, len(vertices))
+	for i, vertex := range vertices {
+		edge := vertices[(i+1)%len(vertices)].Subtract(vertex)
+		if !edge.IsZero() {
+			axes = append(axes, edge.Normal().Normalize())
+		}
+	}
+
+	return axes
+}
+
+// projectVertices returns minimum and maximum of vertices projected onto the axis.
+func projectVertices(vertices []Point[float64], axis Vector[float64]) (float64, float64) {
+	minimum, maximum := math.Inf(1), math.Inf(-1)
+	for _, vertex := range vertices {
+		projection := vertex.Vector().Dot(axis)
+		minimum, maximum = min(minimum, projection), max(maximum, projection)
+	}
+
+	return minimum, maximum
+}
+
+// vectorCast converts a [float64] vector to a vector of given type.
+func vectorCast[T Number](vector Vector[float64]) Vector[T] {
+	return Vector[T]{Cast[T](vector.X), Cast[T](vector.Y)}
+}
+
+// pointCast converts a [float64] point to a point of given type.
+func pointCast[T Number](point Point[float64]) Point[T] {
+	return Point[T]{Cast[T](point.X), Cast[T](point.Y)}
+}
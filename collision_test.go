@@ -1,6 +1,7 @@
 package geom
 
 import (
+	"math"
 	"testing"
 
 	"github.com/gravitton/assert"
@@ -27,3 +28,273 @@ func TestCollisionRectangleCircle(t *testing.T) {
 	assert.True(t, CollisionRectangleCircle(rectangle, Circ(Pt(110.0, 80.0), 60.0)))
 	assert.False(t, CollisionRectangleCircle(rectangle, Circ(Pt(150.0, 0.0), 40.0)))
 }
+
+func TestCollisionPolygons(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	mtv, ok := CollisionPolygons(square, square.Translate(Vec(1.5, 0.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, -0.5, 0)
+
+	mtv, ok = CollisionPolygons(square, square.Translate(Vec(0.25, -1.75)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0.25)
+
+	_, ok = CollisionPolygons(square, square.Translate(Vec(2.5, 0.0)))
+	assert.False(t, ok)
+
+	mtvInt, ok := CollisionPolygons(polygonInt, polygonInt.Translate(Vec(1, 0)))
+	assert.True(t, ok)
+	AssertVector(t, mtvInt, -1, 0)
+}
+
+func TestCollisionPolygonCircle(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	mtv, ok := CollisionPolygonCircle(square, Circ(Pt(3.0, 1.0), 1.5))
+	assert.True(t, ok)
+	AssertVector(t, mtv, -0.5, 0)
+
+	_, ok = CollisionPolygonCircle(square, Circ(Pt(5.0, 1.0), 1.0))
+	assert.False(t, ok)
+
+	_, ok = CollisionPolygonCircle(square, Circ(Pt(3.0, 3.0), 1.0))
+	assert.False(t, ok)
+
+	mtv, ok = CollisionPolygonCircle(square, Circ(Pt(3.0, 3.0), 2.0))
+	assert.True(t, ok)
+	AssertVector(t, mtv, -(2-math.Sqrt2)*OneOverSqrt2, -(2-math.Sqrt2)*OneOverSqrt2)
+}
+
+func TestCollisionPolygonRectangle(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	mtv, ok := CollisionPolygonRectangle(square, Rect(Pt(2.5, 1.0), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, -0.5, 0)
+
+	mtv, ok = CollisionPolygonRectangle(square, Rect(Pt(3.0, 1.0), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0)
+
+	_, ok = CollisionPolygonRectangle(square, Rect(Pt(3.5, 1.0), Sz(2.0, 2.0)))
+	assert.False(t, ok)
+}
+
+func TestCollisionRegularPolygons(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	mtv, ok := CollisionRegularPolygons(hexagon, hexagon.Translate(Vec(0.0, 1.5)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 1.5-Sqrt3)
+
+	_, ok = CollisionRegularPolygons(hexagon, hexagon.Translate(Vec(0.0, 2.0)))
+	assert.False(t, ok)
+}
+
+func TestCollisionRegularPolygonCircle(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	mtv, ok := CollisionRegularPolygonCircle(hexagon, Circ(Pt(0.0, 1.5), 1.0))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0.5-Sqrt3/2)
+
+	_, ok = CollisionRegularPolygonCircle(hexagon, Circ(Pt(0.0, 2.0), 1.0))
+	assert.False(t, ok)
+}
+
+func TestCollisionRegularPolygonRectangle(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	mtv, ok := CollisionRegularPolygonRectangle(hexagon, Rect(Pt(0.0, 1.5), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0.5-Sqrt3/2)
+
+	_, ok = CollisionRegularPolygonRectangle(hexagon, Rect(Pt(0.0, 2.0), Sz(2.0, 2.0)))
+	assert.False(t, ok)
+}
+
+func TestCollisionRegularPolygonPolygon(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+	triangle := Pol([]Point[float64]{{0, 0.5}, {1, 2}, {-1, 2}})
+
+	mtv, ok := CollisionRegularPolygonPolygon(hexagon, triangle)
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0.5-Sqrt3/2)
+
+	_, ok = CollisionRegularPolygonPolygon(hexagon, triangle.Translate(Vec(0.0, 1.0)))
+	assert.False(t, ok)
+}
+
+func TestContactRectangles(t *testing.T) {
+	manifold, ok := ContactRectangles(Rect(Pt(0.0, 0.0), Sz(4.0, 4.0)), Rect(Pt(3.0, 1.0), Sz(4.0, 4.0)))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 1, 0)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{1, -1}, {1, 2}})
+	assert.EqualDelta(t, manifold.Depth, 1.0, Delta)
+
+	_, ok = ContactRectangles(Rect(Pt(0.0, 0.0), Sz(4.0, 4.0)), Rect(Pt(5.0, 1.0), Sz(4.0, 4.0)))
+	assert.False(t, ok)
+}
+
+func TestContactCircles(t *testing.T) {
+	manifold, ok := ContactCircles(Circ(Pt(0.0, 0.0), 2.0), Circ(Pt(3.0, 0.0), 2.0))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 1, 0)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{1, 0}})
+	assert.EqualDelta(t, manifold.Depth, 1.0, Delta)
+
+	_, ok = ContactCircles(Circ(Pt(0.0, 0.0), 2.0), Circ(Pt(5.0, 0.0), 2.0))
+	assert.False(t, ok)
+}
+
+func TestContactRectangleCircle(t *testing.T) {
+	rectangle := Rect(Pt(0.0, 0.0), Sz(4.0, 4.0))
+
+	manifold, ok := ContactRectangleCircle(rectangle, Circ(Pt(3.0, 0.0), 1.5))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 1, 0)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{1.5, 0}})
+	assert.EqualDelta(t, manifold.Depth, 0.5, Delta)
+
+	manifold, ok = ContactRectangleCircle(rectangle, Circ(Pt(0.5, 1.5), 1.0))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{0.5, 0.5}})
+	assert.EqualDelta(t, manifold.Depth, 1.5, Delta)
+
+	_, ok = ContactRectangleCircle(rectangle, Circ(Pt(4.0, 0.0), 1.5))
+	assert.False(t, ok)
+}
+
+func TestContactPolygons(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+	triangle := Pol([]Point[float64]{{1, 1.5}, {2, 3}, {0, 3}})
+
+	manifold, ok := ContactPolygons(square, triangle)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{1, 1.5}})
+	assert.EqualDelta(t, manifold.Depth, 0.5, Delta)
+
+	manifold, ok = ContactPolygons(triangle, square)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, -1)
+
+	_, ok = ContactPolygons(square, triangle.Translate(Vec(0.0, 1.0)))
+	assert.False(t, ok)
+}
+
+func TestContactPolygons_Rotated(t *testing.T) {
+	// squares rotated by 30 degrees overlapping face to face
+	u, v := VectorFromAngle(math.Pi/6, 1.0), VectorFromAngle(math.Pi/6+math.Pi/2, 1.0)
+	square := Pol([]Point[float64]{{0, 0}, u.Multiply(2).Point(), u.Add(v).Multiply(2).Point(), v.Multiply(2).Point()})
+	shifted := square.Translate(v.Multiply(1.5).Add(u.Multiply(0.5)))
+
+	manifold, ok := ContactPolygons(square, shifted)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, v.X, v.Y)
+	assert.EqualDelta(t, manifold.Depth, 0.5, Delta)
+	assert.Length(t, manifold.Contacts, 2)
+	assertContactsOnEdge(t, manifold, square, shifted)
+
+	// skewed parallelogram penetrated by triangle vertex
+	parallelogram := Pol([]Point[float64]{{0, 0}, {4, 0}, {5, 2}, {1, 2}})
+	triangle := Pol([]Point[float64]{{2.5, 1.7}, {4, 3}, {1, 3}})
+
+	manifold, ok = ContactPolygons(parallelogram, triangle)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	assert.EqualDelta(t, manifold.Depth, 0.3, Delta)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{2.5, 1.7}})
+	assertContactsOnEdge(t, manifold, parallelogram, triangle)
+}
+
+// assertContactsOnEdge checks that contact points lie on boundary of one polygon (the incident edge) inside the other one.
+func assertContactsOnEdge(t *testing.T, manifold Manifold[float64], polygon1, polygon2 Polygon[float64]) {
+	t.Helper()
+
+	for _, contact := range manifold.Contacts {
+		onBoundary1 := polygon1.ClosestPoint(contact).DistanceTo(contact) <= Delta
+		onBoundary2 := polygon2.ClosestPoint(contact).DistanceTo(contact) <= Delta
+
+		assert.True(t, onBoundary1 && polygon2.Contains(contact) || onBoundary2 && polygon1.Contains(contact))
+	}
+}
+
+func TestContactPolygonCircle(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	manifold, ok := ContactPolygonCircle(square, Circ(Pt(3.0, 1.0), 1.5))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 1, 0)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{1.5, 1}})
+	assert.EqualDelta(t, manifold.Depth, 0.5, Delta)
+
+	_, ok = ContactPolygonCircle(square, Circ(Pt(5.0, 1.0), 1.0))
+	assert.False(t, ok)
+}
+
+func TestContactPolygonRectangle(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	manifold, ok := ContactPolygonRectangle(square, Rect(Pt(2.5, 1.0), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 1, 0)
+	assert.Equal(t, len(manifold.Contacts), 2)
+	assert.EqualDelta(t, manifold.Depth, 0.5, Delta)
+}
+
+func TestContactRegularPolygons(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	manifold, ok := ContactRegularPolygons(hexagon, hexagon.Translate(Vec(0.0, 1.5)))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{0.5, 1.5 - Sqrt3/2}, {-0.5, 1.5 - Sqrt3/2}})
+	assert.EqualDelta(t, manifold.Depth, Sqrt3-1.5, Delta)
+}
+
+func TestContactRegularPolygonCircle(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	manifold, ok := ContactRegularPolygonCircle(hexagon, Circ(Pt(0.0, 1.5), 1.0))
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{0, 0.5}})
+	assert.EqualDelta(t, manifold.Depth, Sqrt3/2-0.5, Delta)
+
+	_, ok = ContactRegularPolygonCircle(hexagon, Circ(Pt(0.0, 2.0), 1.0))
+	assert.False(t, ok)
+}
+
+func TestContactRegularPolygonRectangle(t *testing.T) {
+	// square rotated by 45 degrees resting its vertex in rectangle
+	diamond := RegPol(Pt(0.0, -0.8), SzU(1.0), 4, 0)
+	rect := RectFromMinMax(Pt(-2.0, 0.0), Pt(2.0, 2.0))
+
+	manifold, ok := ContactRegularPolygonRectangle(diamond, rect)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{0, 0.2}})
+	assert.EqualDelta(t, manifold.Depth, 0.2, Delta)
+	assertContactsOnEdge(t, manifold, diamond.Polygon(), rect.Polygon())
+
+	_, ok = ContactRegularPolygonRectangle(diamond.Translate(Vec(0.0, -0.5)), rect)
+	assert.False(t, ok)
+}
+
+func TestContactRegularPolygonPolygon(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+	triangle := Pol([]Point[float64]{{0, 0.5}, {1, 2}, {-1, 2}})
+
+	manifold, ok := ContactRegularPolygonPolygon(hexagon, triangle)
+	assert.True(t, ok)
+	AssertVector(t, manifold.Normal, 0, 1)
+	AssertVertices(t, manifold.Contacts, []Point[float64]{{0, 0.5}})
+	assert.EqualDelta(t, manifold.Depth, Sqrt3/2-0.5, Delta)
+	assertContactsOnEdge(t, manifold, hexagon.Polygon(), triangle)
+
+	_, ok = ContactRegularPolygonPolygon(hexagon, triangle.Translate(Vec(0.0, 1.0)))
+	assert.False(t, ok)
+}
@@ -0,0 +1,198 @@
+package geom
+
+import (
+	"math"
+)
+
+const (
+	convexIterations = 64
+	convexTolerance  = 1e-10
+)
+
+// Convex is a convex shape described by its support function.
+type Convex interface {
+	// Support returns the farthest point of the shape in the given direction.
+	Support(direction Vector[float64]) Point[float64]
+}
+
+// CollisionConvex checks if the given convex shapes collide using Gilbert–Johnson–Keerthi algorithm.
+func CollisionConvex(shape1, shape2 Convex) bool {
+	_, _, ok := gjk(minkowski{shape1, shape2})
+
+	return ok
+}
+
+// DistanceConvex returns the distance between the given convex shapes using Gilbert–Johnson–Keerthi algorithm.
+// Colliding shapes have zero distance.
+func DistanceConvex(shape1, shape2 Convex) float64 {
+	distance, _, _ := gjk(minkowski{shape1, shape2})
+
+	return distance
+}
+
+// PenetrationConvex checks if the given convex shapes collide using Gilbert–Johnson–Keerthi algorithm,
+// and returns the minimum translation vector, which pushes the first shape out of the second one, using Expanding Polytope Algorithm.
+func PenetrationConvex(shape1, shape2 Convex) (Vector[float64], bool) {
+	difference := minkowski{shape1, shape2}
+
+	_, simplex, ok := gjk(difference)
+	if !ok {
+		return Vector[float64]{}, false
+	}
+
+	polytope, ok := enclosingTriangle(difference, simplex)
+	if !ok {
+		// touching shapes
+		return Vector[float64]{}, true
+	}
+
+	return epa(difference, polytope).Negate(), true
+}
+
+// minkowski is Minkowski difference of two convex shapes.
+type minkowski struct {
+	shape1, shape2 Convex
+}
+
+// support returns the farthest point of Minkowski difference in the given direction.
+func (m minkowski) support(direction Vector[float64]) Vector[float64] {
+	return m.shape1.Support(direction).Subtract(m.shape2.Support(direction.Negate()))
+}
+
+// gjk returns distance of the Minkowski difference from origin and the final simplex.
+func gjk(difference minkowski) (float64, []Vector[float64], bool) {
+	simplex := []Vector[float64]{difference.support(Vector[float64]{1, 0})}
+
+	var closest Vector[float64]
+	for range convexIterations {
+		var inside bool
+		closest, simplex, inside = closestSimplex(simplex)
+		if inside || closest.LengthSquared() <= convexTolerance*convexTolerance {
+			return 0, simplex, true
+		}
+
+		point := difference.support(closest.Negate())
+
+		// no more progress towards origin
+		if closest.LengthSquared()-point.Dot(closest) <= convexTolerance*max(1, closest.LengthSquared()) {
+			break
+		}
+
+		simplex = append(simplex, point)
+	}
+
+	return closest.Length(), simplex, false
+}
+
+// closestSimplex returns the point of simplex closest to origin and the reduced simplex containing it.
+func closestSimplex(simplex []Vector[float64]) (Vector[float64], []Vector[float64], bool) {
+	switch len(simplex) {
+	case 1:
+		return simplex[0], simplex, false
+	case 2:
+		return closestSegment(simplex[0], simplex[1])
+	}
+
+	a, b, c := simplex[0], simplex[1], simplex[2]
+
+	// origin inside non-degenerate triangle
+	if area := b.Subtract(a).Cross(c.Subtract(a)); !equalDelta(area, 0, convexTolerance) {
+		sign := math.Copysign(1, area)
+		if sign*b.Subtract(a).Cross(a.Negate()) >= 0 && sign*c.Subtract(b).Cross(b.Negate()) >= 0 && sign*a.Subtract(c).Cross(c.Negate()) >= 0 {
+			return Vector[float64]{}, simplex, true
+		}
+	}
+
+	closest, reduced, inside := closestSegment(a, b)
+	for _, edge := range [][2]Vector[float64]{{b, c}, {c, a}} {
+		point, candidate, in := closestSegment(edge[0], edge[1])
+		if point.LengthSquared() < closest.LengthSquared() {
+			closest, reduced, inside = point, candidate, in
+		}
+	}
+
+	return closest, reduced, inside
+}
+
+// closestSegment returns the point of segment closest to origin and the reduced simplex containing it.
+func closestSegment(a, b Vector[float64]) (Vector[float64], []Vector[float64], bool) {
+	ab := b.Subtract(a)
+
+	lengthSquared := ab.LengthSquared()
+	if lengthSquared == 0 {
+		return a, []Vector[float64]{a}, false
+	}
+
+	t := -a.Dot(ab) / lengthSquared
+	switch {
+	case t <= 0:
+		return a, []Vector[float64]{a}, false
+	case t >= 1:
+		return b, []Vector[float64]{b}, false
+	default:
+		return a.Add(ab.Multiply(t)), []Vector[float64]{a, b}, false
+	}
+}
+
+// enclosingTriangle expands simplex containing origin to a non-degenerate triangle.
+func enclosingTriangle(difference minkowski, simplex []Vector[float64]) ([]Vector[float64], bool) {
+	if len(simplex) == 3 && !equalDelta(simplex[1].Subtract(simplex[0]).Cross(simplex[2].Subtract(simplex[0])), 0, convexTolerance) {
+		return simplex, true
+	}
+
+	directions := []Vector[float64]{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+	if len(simplex) >= 2 {
+		normal := simplex[1].Subtract(simplex[0]).Normal()
+		directions = append([]Vector[float64]{normal, normal.Negate()}, directions...)
+	}
+
+	polytope := simplex[:1]
+	for _, direction := range directions {
+		point := difference.support(direction)
+
+		switch len(polytope) {
+		case 1:
+			if !point.Equal(polytope[0]) {
+				polytope = append(polytope, point)
+			}
+		case 2:
+			if !equalDelta(polytope[1].Subtract(polytope[0]).Cross(point.Subtract(polytope[0])), 0, convexTolerance) {
+				return append(polytope, point), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// epa returns penetration vector (from origin to the nearest boundary point) of Minkowski difference.
+func epa(difference minkowski, polytope []Vector[float64]) Vector[float64] {
+	// counter-clockwise (in standard Y up orientation) polytope has outward normals on the right side of edges
+	if polytope[1].Subtract(polytope[0]).Cross(polytope[2].Subtract(polytope[0])) < 0 {
+		polytope[1], polytope[2] = polytope[2], polytope[1]
+	}
+
+	var normal Vector[float64]
+	var distance float64
+	for range convexIterations {
+		index := 0
+		distance = math.Inf(1)
+		for i, a := range polytope {
+			b := polytope[(i+1)%len(polytope)]
+
+			edgeNormal := Vector[float64]{b.Y - a.Y, a.X - b.X}.Normalize()
+			if edgeDistance := edgeNormal.Dot(a); edgeDistance < distance {
+				index, normal, distance = i, edgeNormal, edgeDistance
+			}
+		}
+
+		point := difference.support(normal)
+		if point.Dot(normal)-distance <= convexTolerance*max(1, distance) {
+			break
+		}
+
+		polytope = append(polytope[:index+1], append([]Vector[float64]{point}, polytope[index+1:]...)...)
+	}
+
+	return normal.Multiply(distance)
+}
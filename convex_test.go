@@ -0,0 +1,63 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestCollisionConvex(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	assert.True(t, CollisionConvex(square, square.Translate(Vec(1.5, 0.0))))
+	assert.True(t, CollisionConvex(square, square.Translate(Vec(2.0, 2.0))))
+	assert.False(t, CollisionConvex(square, square.Translate(Vec(2.5, 0.0))))
+
+	assert.True(t, CollisionConvex(Circ(Pt(0.0, 0.0), 2.0), Circ(Pt(3.0, 0.0), 2.0)))
+	assert.False(t, CollisionConvex(Circ(Pt(0.0, 0.0), 2.0), Circ(Pt(5.0, 0.0), 2.0)))
+
+	assert.True(t, CollisionConvex(Ln(Pt(-1, 1), Pt(3, 1)), square))
+	assert.True(t, CollisionConvex(Ln(Pt(-1, -1), Pt(3, 3)), Circ(Pt(1, 1), 1)))
+	assert.False(t, CollisionConvex(Ln(Pt(-1, 3), Pt(3, 3)), Rect(Pt(1, 1), Sz(2, 2))))
+
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+	assert.True(t, CollisionConvex(hexagon, hexagon.Translate(Vec(0.0, 1.5))))
+	assert.False(t, CollisionConvex(hexagon, hexagon.Translate(Vec(0.0, 2.0))))
+}
+
+func TestDistanceConvex(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	assert.EqualDelta(t, DistanceConvex(square, square.Translate(Vec(3.0, 0.0))), 1.0, Delta)
+	assert.EqualDelta(t, DistanceConvex(square, square.Translate(Vec(5.0, 6.0))), 5.0, Delta)
+	assert.EqualDelta(t, DistanceConvex(square, square.Translate(Vec(1.0, 1.0))), 0.0, Delta)
+
+	assert.EqualDelta(t, DistanceConvex(Circ(Pt(0.0, 0.0), 1.0), Circ(Pt(5.0, 0.0), 1.0)), 3.0, Delta)
+	assert.EqualDelta(t, DistanceConvex(Circ(Pt(4.0, 4.0), 1.0), square), 2*math.Sqrt2-1, Delta)
+	assert.EqualDelta(t, DistanceConvex(Ln(Pt(-1, 5), Pt(3, 5)), Rect(Pt(1, 1), Sz(2, 2))), 3.0, Delta)
+}
+
+func TestPenetrationConvex(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+
+	mtv, ok := PenetrationConvex(square, square.Translate(Vec(1.5, 0.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, -0.5, 0)
+
+	mtv, ok = PenetrationConvex(square, Rect(Pt(1.0, 2.5), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, -0.5)
+
+	mtv, ok = PenetrationConvex(square, square.Translate(Vec(2.0, 0.0)))
+	assert.True(t, ok)
+	AssertVector(t, mtv, 0, 0)
+
+	mtv, ok = PenetrationConvex(Circ(Pt(0.0, 0.0), 2.0), Circ(Pt(3.0, 0.0), 2.0))
+	assert.True(t, ok)
+	assert.EqualDelta(t, mtv.X, -1.0, 1e-3)
+	assert.EqualDelta(t, mtv.Y, 0.0, 1e-3)
+
+	_, ok = PenetrationConvex(square, square.Translate(Vec(2.5, 0.0)))
+	assert.False(t, ok)
+}
@@ -0,0 +1,49 @@
+package geom
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ConvexHull creates the smallest convex Polygon containing all the given points using monotone chain algorithm.
+// Vertices are in counter-clockwise order (like Rectangle vertices) starting from the leftmost point,
+// duplicate and collinear points are omitted. Less than three non-collinear points result in a degenerate polygon.
+func ConvexHull[T Number](points []Point[T]) Polygon[T] {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(p1, p2 Point[T]) int {
+		return cmp.Or(cmp.Compare(p1.X, p2.X), cmp.Compare(p1.Y, p2.Y))
+	})
+	sorted = slices.Compact(sorted)
+
+	if len(sorted) < 3 {
+		return Polygon[T]{sorted}
+	}
+
+	hull := make([]Point[T], 0, len(sorted)+1)
+
+	// lower chain (on screen) from left to right, then upper chain from right to left
+	for _, point := range sorted {
+		hull = hullAppend(hull, point, 0)
+	}
+	lower := len(hull)
+	for i := len(sorted) - 2; i >= 0; i-- {
+		hull = hullAppend(hull, sorted[i], lower-1)
+	}
+
+	// last point is the starting one
+	return Polygon[T]{hull[:len(hull)-1]}
+}
+
+// hullAppend appends the point to the chain, removing previous points not making a counter-clockwise turn.
+// Points up to the floor index are kept.
+func hullAppend[T Number](hull []Point[T], point Point[T], floor int) []Point[T] {
+	for len(hull) >= floor+2 {
+		a, b := hull[len(hull)-2], hull[len(hull)-1]
+		if b.Subtract(a).Cross(point.Subtract(b)) < 0 {
+			break
+		}
+		hull = hull[:len(hull)-1]
+	}
+
+	return append(hull, point)
+}
@@ -0,0 +1,41 @@
+package geom
+
+import (
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestConvexHull(t *testing.T) {
+	points := []Point[int]{
+		Pt(0, 0), Pt(2, 0), Pt(4, 0), Pt(4, 4), Pt(0, 4), // corners with collinear point
+		Pt(1, 1), Pt(2, 2), Pt(3, 1), // interior
+		Pt(4, 4), Pt(0, 0), // duplicates
+		Pt(0, 2), // collinear on left edge
+	}
+
+	hull := ConvexHull(points)
+	AssertPolygon(t, hull, []Point[int]{Pt(0, 0), Pt(0, 4), Pt(4, 4), Pt(4, 0)})
+	assert.Equal(t, hull.Winding(), CounterClockwise)
+	assert.Equal(t, len(points), 11, "input is not modified")
+	assert.True(t, points[0].Equal(Pt(0, 0)))
+
+	AssertPolygon(t, ConvexHull([]Point[float64]{Pt(1.0, 1.0), Pt(0.5, 3.0), Pt(-1.0, 0.0), Pt(0.0, 1.0)}), []Point[float64]{
+		Pt(-1.0, 0.0), Pt(0.5, 3.0), Pt(1.0, 1.0),
+	})
+}
+
+func TestConvexHull_Degenerate(t *testing.T) {
+	assert.True(t, ConvexHull[int](nil).Empty())
+	AssertPolygon(t, ConvexHull([]Point[int]{Pt(1, 1), Pt(1, 1)}), []Point[int]{Pt(1, 1)})
+	AssertPolygon(t, ConvexHull([]Point[int]{Pt(2, 2), Pt(0, 0)}), []Point[int]{Pt(0, 0), Pt(2, 2)})
+	// collinear points result in a segment
+	AssertPolygon(t, ConvexHull([]Point[int]{Pt(0, 0), Pt(1, 1), Pt(3, 3), Pt(2, 2)}), []Point[int]{Pt(0, 0), Pt(3, 3)})
+}
+
+func TestPolygon_ConvexHull(t *testing.T) {
+	star := Pol([]Point[int]{Pt(0, -4), Pt(1, -1), Pt(4, 0), Pt(1, 1), Pt(0, 4), Pt(-1, 1), Pt(-4, 0), Pt(-1, -1)})
+
+	AssertPolygon(t, star.ConvexHull(), []Point[int]{Pt(-4, 0), Pt(0, 4), Pt(4, 0), Pt(0, -4)})
+	assert.Equal(t, star.ConvexHull().Area(), 32.0)
+}
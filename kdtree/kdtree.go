@@ -0,0 +1,191 @@
+// Package kdtree provides a static 2-d tree for nearest-neighbour point queries.
+package kdtree
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Tree is a balanced 2-d tree of points.
+// Queries return indices of points in the slice the tree was created from.
+type Tree[T geom.Number] struct {
+	// nodes are points in implicit tree order, median of each range is its root node.
+	nodes []node[T]
+}
+
+// node is a point with its index in the source slice.
+type node[T geom.Number] struct {
+	point geom.Point[T]
+	index int
+}
+
+// New creates a Tree from the given points.
+func New[T geom.Number](points []geom.Point[T]) *Tree[T] {
+	nodes := make([]node[T], len(points))
+	for i, point := range points {
+		nodes[i] = node[T]{point, i}
+	}
+
+	build(nodes, 0)
+
+	return &Tree[T]{nodes}
+}
+
+// Len returns number of points.
+func (t *Tree[T]) Len() int {
+	return len(t.nodes)
+}
+
+// Nearest returns index of the point nearest to the given point.
+func (t *Tree[T]) Nearest(point geom.Point[T]) (int, bool) {
+	indices := t.NearestK(point, 1)
+	if len(indices) == 0 {
+		return 0, false
+	}
+
+	return indices[0], true
+}
+
+// NearestK returns indices of up to k points nearest to the given point, ordered by distance and then by index.
+func (t *Tree[T]) NearestK(point geom.Point[T], k int) []int {
+	if k <= 0 {
+		return nil
+	}
+
+	q := make(candidates[T], 0, k)
+	t.nearest(0, len(t.nodes), 0, point, k, &q)
+
+	slices.SortFunc(q, func(c1, c2 candidate[T]) int {
+		return cmp.Or(cmp.Compare(c1.distance, c2.distance), cmp.Compare(c1.index, c2.index))
+	})
+
+	indices := make([]int, len(q))
+	for i, c := range q {
+		indices[i] = c.index
+	}
+
+	return indices
+}
+
+// Radius returns indices of points within the given distance from the point, in no particular order.
+// Negative radius finds no points.
+func (t *Tree[T]) Radius(point geom.Point[T], radius T) []int {
+	if radius < 0 {
+		return nil
+	}
+
+	var indices []int
+	t.radius(0, len(t.nodes), 0, point, radius*radius, &indices)
+
+	return indices
+}
+
+// nearest collects k nearest nodes within range into max-heap of candidates.
+func (t *Tree[T]) nearest(low, high, depth int, point geom.Point[T], k int, q *candidates[T]) {
+	if low >= high {
+		return
+	}
+
+	mid := (low + high) / 2
+	n := t.nodes[mid]
+
+	if c := (candidate[T]{n.point.DistanceSquaredTo(point), n.index}); len(*q) < k {
+		heap.Push(q, c)
+	} else if c.before((*q)[0]) {
+		(*q)[0] = c
+		heap.Fix(q, 0)
+	}
+
+	delta := axisDelta(point, n.point, depth)
+	if delta < 0 {
+		t.nearest(low, mid, depth+1, point, k, q)
+		if len(*q) < k || delta*delta <= (*q)[0].distance {
+			t.nearest(mid+1, high, depth+1, point, k, q)
+		}
+	} else {
+		t.nearest(mid+1, high, depth+1, point, k, q)
+		if len(*q) < k || delta*delta <= (*q)[0].distance {
+			t.nearest(low, mid, depth+1, point, k, q)
+		}
+	}
+}
+
+// radius collects indices of nodes within range closer than squared radius.
+func (t *Tree[T]) radius(low, high, depth int, point geom.Point[T], radiusSquared T, indices *[]int) {
+	if low >= high {
+		return
+	}
+
+	mid := (low + high) / 2
+	n := t.nodes[mid]
+
+	if n.point.DistanceSquaredTo(point) <= radiusSquared {
+		*indices = append(*indices, n.index)
+	}
+
+	delta := axisDelta(point, n.point, depth)
+	if delta <= 0 || delta*delta <= radiusSquared {
+		t.radius(low, mid, depth+1, point, radiusSquared, indices)
+	}
+	if delta >= 0 || delta*delta <= radiusSquared {
+		t.radius(mid+1, high, depth+1, point, radiusSquared, indices)
+	}
+}
+
+// build orders nodes so the median by depth axis is in the middle of each range.
+func build[T geom.Number](nodes []node[T], depth int) {
+	if len(nodes) <= 1 {
+		return
+	}
+
+	slices.SortFunc(nodes, func(n1, n2 node[T]) int {
+		return cmp.Compare(axis(n1.point, depth), axis(n2.point, depth))
+	})
+
+	mid := len(nodes) / 2
+	build(nodes[:mid], depth+1)
+	build(nodes[mid+1:], depth+1)
+}
+
+// axis returns point coordinate used for splitting at the given depth.
+func axis[T geom.Number](point geom.Point[T], depth int) T {
+	if depth%2 == 0 {
+		return point.X
+	}
+
+	return point.Y
+}
+
+// axisDelta returns signed distance from the splitting node to the point along depth axis.
+func axisDelta[T geom.Number](point, split geom.Point[T], depth int) T {
+	return axis(point, depth) - axis(split, depth)
+}
+
+// candidate is a point index with its squared distance.
+type candidate[T geom.Number] struct {
+	distance T
+	index    int
+}
+
+// before checks if the candidate is nearer than the other one, equally distant candidates are ordered by index.
+func (c candidate[T]) before(other candidate[T]) bool {
+	return c.distance < other.distance || (c.distance == other.distance && c.index < other.index)
+}
+
+// candidates is a max-heap of candidates ordered by distance and index.
+type candidates[T geom.Number] []candidate[T]
+
+func (c candidates[T]) Len() int           { return len(c) }
+func (c candidates[T]) Less(i, j int) bool { return c[j].before(c[i]) }
+func (c candidates[T]) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c *candidates[T]) Push(x any)        { *c = append(*c, x.(candidate[T])) }
+func (c *candidates[T]) Pop() any {
+	old := *c
+	x := old[len(old)-1]
+	*c = old[:len(old)-1]
+
+	return x
+}
@@ -0,0 +1,74 @@
+package kdtree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+var points = []geom.Point[int]{
+	geom.Pt(2, 3), geom.Pt(5, 4), geom.Pt(9, 6), geom.Pt(4, 7), geom.Pt(8, 1), geom.Pt(7, 2),
+}
+
+func TestTree_Nearest(t *testing.T) {
+	tree := New(points)
+
+	index, ok := tree.Nearest(geom.Pt(9, 2))
+	assert.True(t, ok)
+	assert.Equal(t, index, 4)
+
+	index, ok = tree.Nearest(geom.Pt(4, 7))
+	assert.True(t, ok)
+	assert.Equal(t, index, 3)
+
+	_, ok = New[float64](nil).Nearest(geom.Pt(0.0, 0.0))
+	assert.False(t, ok)
+}
+
+func TestTree_NearestK(t *testing.T) {
+	tree := New(points)
+
+	assert.Equal(t, tree.Len(), 6)
+	// equally distant points are ordered by index
+	assert.Equal(t, tree.NearestK(geom.Pt(6, 3), 3), []int{1, 5, 4})
+	assert.Equal(t, len(tree.NearestK(geom.Pt(6, 3), 10)), 6)
+	assert.Equal(t, len(tree.NearestK(geom.Pt(6, 3), 0)), 0)
+}
+
+func TestTree_Radius(t *testing.T) {
+	tree := New(points)
+
+	assert.Equal(t, slices.Sorted(slices.Values(tree.Radius(geom.Pt(6, 3), 2))), []int{1, 5})
+	assert.Equal(t, slices.Sorted(slices.Values(tree.Radius(geom.Pt(8, 3), 2))), []int{4, 5})
+	assert.Equal(t, len(tree.Radius(geom.Pt(0, 10), 2)), 0)
+}
+
+func TestTree_Duplicates(t *testing.T) {
+	duplicates := make([]geom.Point[int], 9)
+	for i := range duplicates {
+		duplicates[i] = geom.Pt(3, 3)
+	}
+	tree := New(duplicates)
+
+	// equally distant points beyond k are dropped by index, not by traversal order
+	assert.Equal(t, tree.NearestK(geom.Pt(0, 0), 3), []int{0, 1, 2})
+	assert.Equal(t, len(tree.Radius(geom.Pt(3, 3), 0)), 9)
+}
+
+func TestTree_Edges(t *testing.T) {
+	tree := New(points)
+
+	// nearest point lies on the other side of the root split
+	index, ok := tree.Nearest(geom.Pt(6, 6))
+	assert.True(t, ok)
+	assert.Equal(t, index, 1)
+	// points exactly at radius distance are included, negative radius finds nothing
+	assert.Equal(t, slices.Sorted(slices.Values(tree.Radius(geom.Pt(5, 7), 1))), []int{3})
+	assert.Equal(t, len(tree.Radius(geom.Pt(5, 4), -1)), 0)
+
+	single := New([]geom.Point[float64]{geom.Pt(1.0, 1.0)})
+	assert.Equal(t, single.NearestK(geom.Pt(5.0, 5.0), 3), []int{0})
+	assert.Equal(t, len(single.Radius(geom.Pt(5.0, 5.0), 1)), 0)
+}
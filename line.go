@@ -2,6 +2,7 @@ package geom
 
 import (
 	"fmt"
+	"math"
 )
 
 // Line is a 2D line.
@@ -20,6 +21,11 @@ func (l Line[T]) Translate(vector Vector[T]) Line[T] {
 	return Line[T]{l.Start.Add(vector), l.End.Add(vector)}
 }
 
+// TranslateShape creates a new Line translated by the given vector as Shape.
+func (l Line[T]) TranslateShape(vector Vector[T]) Shape[T] {
+	return l.Translate(vector)
+}
+
 // MoveTo creates a new Line with the start point moved to point and same length and direction.
 func (l Line[T]) MoveTo(point Point[T]) Line[T] {
 	return Line[T]{point, l.End.Add(point.Subtract(l.Start))}
@@ -35,6 +41,11 @@ func (l Line[T]) Midpoint() Point[T] {
 	return l.Start.Midpoint(l.End)
 }
 
+// Centroid returns the midpoint of the line.
+func (l Line[T]) Centroid() Point[T] {
+	return l.Midpoint()
+}
+
 // Direction returns the direction vector of the line.
 func (l Line[T]) Direction() Vector[T] {
 	return l.End.Subtract(l.Start)
@@ -45,9 +56,68 @@ func (l Line[T]) Length() float64 {
 	return l.Direction().Length()
 }
 
+// Intersect returns the intersection of two line segments.
+// Crossing segments intersect in a single point (returned as zero-length line),
+// collinear segments intersect in their overlapping sub-segment.
+func (l Line[T]) Intersect(line Line[T]) (Line[T], bool) {
+	start, end, ok := intersectSegments(l.Float(), line.Float())
+	if !ok {
+		return Line[T]{}, false
+	}
+
+	return Line[T]{pointCast[T](start), pointCast[T](end)}, true
+}
+
+// Side returns on which side of the line the given point lies: +1 on the right (with +Y down), -1 on the left,
+// 0 on the line (within Delta of it, matching Contains and DistanceTo).
+func (l Line[T]) Side(point Point[T]) int {
+	direction := l.Direction().Float()
+	cross := direction.Cross(point.Subtract(l.Start).Float())
+
+	switch {
+	case math.Abs(cross) <= Delta*direction.Length():
+		return 0
+	case cross > 0:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// Project returns the point on the line segment nearest to the given point.
+func (l Line[T]) Project(point Point[T]) Point[T] {
+	return pointCast[T](projectSegment(l.Start.Float(), l.End.Float(), point.Float()))
+}
+
+// ClosestPoint returns the point on the line segment nearest to the given point (same as Project).
+func (l Line[T]) ClosestPoint(point Point[T]) Point[T] {
+	return l.Project(point)
+}
+
+// DistanceTo returns euclidean distance from the line segment to the given point.
+func (l Line[T]) DistanceTo(point Point[T]) float64 {
+	return projectSegment(l.Start.Float(), l.End.Float(), point.Float()).DistanceTo(point.Float())
+}
+
+// SignedDistance returns distance from the line segment to the given point; line has no inside, so it is never negative.
+// Subtract half of the thickness to get signed distance of a capsule around the line.
+func (l Line[T]) SignedDistance(point Point[T]) float64 {
+	return l.DistanceTo(point)
+}
+
+// Support returns the line endpoint farthest in the given direction.
+func (l Line[T]) Support(direction Vector[float64]) Point[float64] {
+	start, end := l.Start.Float(), l.End.Float()
+	if end.Vector().Dot(direction) > start.Vector().Dot(direction) {
+		return end
+	}
+
+	return start
+}
+
 // Bounds returns the axis-aligned bounding rectangle.
 func (l Line[T]) Bounds() Rectangle[T] {
-	minPoint := Point[T]{min(l.Start.X, l.End.Y), min(l.Start.Y, l.End.Y)}
+	minPoint := Point[T]{min(l.Start.X, l.End.X), min(l.Start.Y, l.End.Y)}
 
 	return RectFromMin(minPoint, l.Direction().Size())
 }
@@ -62,6 +132,16 @@ func (l Line[T]) IsZero() bool {
 	return l.Start.IsZero() && l.End.IsZero()
 }
 
+// CastRay casts the given ray against the line (same as Ray.CastLine).
+func (l Line[T]) CastRay(ray Ray[T]) (RayHit[T], bool) {
+	return ray.CastLine(l)
+}
+
+// Contains checks if the given point lies on the line segment.
+func (l Line[T]) Contains(point Point[T]) bool {
+	return l.DistanceTo(point) <= Delta
+}
+
 // Int converts the line to a [int] line.
 func (l Line[T]) Int() Line[int] {
 	return Line[int]{l.Start.Int(), l.End.Int()}
@@ -76,3 +156,59 @@ func (l Line[T]) Float() Line[float64] {
 func (l Line[T]) String() string {
 	return fmt.Sprintf("L(%s;%s)", l.Start.String(), l.End.String())
 }
+
+// intersectSegments returns the intersection of two segments as a (possibly zero-length) segment.
+func intersectSegments(line1, line2 Line[float64]) (Point[float64], Point[float64], bool) {
+	direction1, direction2 := line1.Direction(), line2.Direction()
+	offset := line2.Start.Subtract(line1.Start)
+	denominator := direction1.Cross(direction2)
+
+	if !equalDelta(denominator, 0, Delta*Delta) {
+		t := offset.Cross(direction2) / denominator
+		u := offset.Cross(direction1) / denominator
+		if t < 0 || t > 1 || u < 0 || u > 1 {
+			return Point[float64]{}, Point[float64]{}, false
+		}
+
+		point := line1.Start.Add(direction1.Multiply(t))
+
+		return point, point, true
+	}
+
+	// degenerate first segment is a point
+	lengthSquared := direction1.LengthSquared()
+	if lengthSquared == 0 {
+		if !projectSegment(line2.Start, line2.End, line1.Start).Equal(line1.Start) {
+			return Point[float64]{}, Point[float64]{}, false
+		}
+
+		return line1.Start, line1.Start, true
+	}
+
+	// parallel but not collinear
+	if !equalDelta(offset.Cross(direction1)/math.Sqrt(lengthSquared), 0, Delta) {
+		return Point[float64]{}, Point[float64]{}, false
+	}
+
+	// collinear, overlap of parameter intervals
+	t1 := offset.Dot(direction1) / lengthSquared
+	t2 := t1 + direction2.Dot(direction1)/lengthSquared
+	low, high := max(0, min(t1, t2)), min(1, max(t1, t2))
+	if low > high {
+		return Point[float64]{}, Point[float64]{}, false
+	}
+
+	return line1.Start.Add(direction1.Multiply(low)), line1.Start.Add(direction1.Multiply(high)), true
+}
+
+// projectSegment returns the point on the segment nearest to the given point.
+func projectSegment(start, end, point Point[float64]) Point[float64] {
+	direction := end.Subtract(start)
+
+	lengthSquared := direction.LengthSquared()
+	if lengthSquared == 0 {
+		return start
+	}
+
+	return start.Add(direction.Multiply(Clamp(point.Subtract(start).Dot(direction)/lengthSquared, 0, 1)))
+}
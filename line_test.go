@@ -48,12 +48,96 @@ func TestLine_Length(t *testing.T) {
 	assert.EqualDelta(t, lineFloat.Length(), math.Sqrt(13.6825), Delta)
 }
 
+func TestLine_Intersect(t *testing.T) {
+	line := Ln(Pt(0.0, 0.0), Pt(4.0, 4.0))
+
+	intersection, ok := line.Intersect(Ln(Pt(0.0, 4.0), Pt(4.0, 0.0)))
+	assert.True(t, ok)
+	AssertLine(t, intersection, 2, 2, 2, 2)
+
+	intersection, ok = line.Intersect(Ln(Pt(2.0, 2.0), Pt(6.0, 6.0)))
+	assert.True(t, ok)
+	AssertLine(t, intersection, 2, 2, 4, 4)
+
+	intersection, ok = line.Intersect(Ln(Pt(5.0, 5.0), Pt(-1.0, -1.0)))
+	assert.True(t, ok)
+	AssertLine(t, intersection, 0, 0, 4, 4)
+
+	intersection, ok = line.Intersect(Ln(Pt(4.0, 4.0), Pt(6.0, 0.0)))
+	assert.True(t, ok)
+	AssertLine(t, intersection, 4, 4, 4, 4)
+
+	_, ok = line.Intersect(Ln(Pt(5.0, 5.0), Pt(6.0, 6.0)))
+	assert.False(t, ok)
+	_, ok = line.Intersect(Ln(Pt(0.0, 1.0), Pt(4.0, 5.0)))
+	assert.False(t, ok)
+	_, ok = line.Intersect(Ln(Pt(3.0, 0.0), Pt(6.0, 0.0)))
+	assert.False(t, ok)
+
+	intersectionInt, ok := lineInt.Intersect(Ln(Pt(1, 5), Pt(3, 2)))
+	assert.True(t, ok)
+	AssertLine(t, intersectionInt, 2, 4, 2, 4)
+}
+
+func TestLine_Side(t *testing.T) {
+	assert.Equal(t, lineInt.Side(Pt(3, 2)), -1)
+	assert.Equal(t, lineInt.Side(Pt(1, 5)), 1)
+	assert.Equal(t, lineInt.Side(Pt(5, 8)), 0)
+
+	assert.Equal(t, Ln(Pt(0.0, 0.0), Pt(1.0, 0.0)).Side(Pt(0.5, 1.0)), 1)
+	assert.Equal(t, Ln(Pt(0.0, 0.0), Pt(1.0, 0.0)).Side(Pt(0.5, -1.0)), -1)
+	// short segments classify by distance, not by raw cross product
+	short := Ln(Pt(0.0, 0.0), Pt(0.001, 0.0))
+	assert.Equal(t, short.Side(Pt(0.0005, 0.0009)), 1)
+	assert.False(t, short.Contains(Pt(0.0005, 0.0009)))
+	assert.Equal(t, short.Side(Pt(0.0005, 1e-7)), 0)
+	assert.Equal(t, Ln(Pt(0.0, 0.0), Pt(1e-7, 0.0)).Side(Pt(0.0, 5.0)), 1)
+}
+
+func TestLine_Project(t *testing.T) {
+	line := Ln(Pt(0.0, 0.0), Pt(4.0, 0.0))
+
+	AssertPoint(t, line.Project(Pt(1.5, 3.0)), 1.5, 0)
+	AssertPoint(t, line.Project(Pt(-2.0, 1.0)), 0, 0)
+	AssertPoint(t, line.Project(Pt(6.0, -1.0)), 4, 0)
+	AssertPoint(t, Ln(Pt(1.0, 1.0), Pt(1.0, 1.0)).Project(Pt(6.0, -1.0)), 1, 1)
+	AssertPoint(t, lineInt.Project(Pt(6, 3)), 3, 5)
+}
+
+func TestLine_ClosestPoint(t *testing.T) {
+	AssertPoint(t, Ln(Pt(0.0, 0.0), Pt(4.0, 0.0)).ClosestPoint(Pt(1.5, 3.0)), 1.5, 0)
+	AssertPoint(t, lineInt.ClosestPoint(Pt(0, 0)), 1, 2)
+}
+
+func TestLine_DistanceTo(t *testing.T) {
+	line := Ln(Pt(0.0, 0.0), Pt(4.0, 0.0))
+
+	assert.EqualDelta(t, line.DistanceTo(Pt(1.5, 3.0)), 3.0, Delta)
+	assert.EqualDelta(t, line.DistanceTo(Pt(-3.0, 4.0)), 5.0, Delta)
+	assert.EqualDelta(t, line.DistanceTo(Pt(2.0, 0.0)), 0.0, Delta)
+	assert.EqualDelta(t, lineInt.DistanceTo(Pt(6, 3)), math.Sqrt(13), Delta)
+}
+
+func TestLine_SignedDistance(t *testing.T) {
+	line := Ln(Pt(0.0, 0.0), Pt(4.0, 0.0))
+
+	assert.EqualDelta(t, line.SignedDistance(Pt(1.5, 3.0)), 3.0, Delta)
+	assert.EqualDelta(t, line.SignedDistance(Pt(2.0, 0.0)), 0.0, Delta)
+	assert.EqualDelta(t, line.SignedDistance(Pt(7.0, -4.0)), 5.0, Delta)
+}
+
+func TestLine_Support(t *testing.T) {
+	AssertPoint(t, lineInt.Support(Vec(1.0, 1.0)), 3, 5)
+	AssertPoint(t, lineInt.Support(Vec(-1.0, 0.0)), 1, 2)
+}
+
 func TestLine_Bounds(t *testing.T) {
 	AssertRect(t, lineInt.Bounds(), 2, 3, 2, 3)
 	assert.Equal(t, lineInt.Start, lineInt.Bounds().Min())
 	assert.Equal(t, lineInt.End, lineInt.Bounds().Max())
 
 	AssertRect(t, lineFloat.Bounds(), 0.9, 1.575, 0.6, 3.65)
+	AssertRect(t, Ln(Pt(5, 0), Pt(1, 4)).Bounds(), 3, 2, 4, 4)
 }
 
 func TestLine_Equal(t *testing.T) {
@@ -75,6 +159,14 @@ func TestLine_IsZero(t *testing.T) {
 	assert.False(t, lineFloat.IsZero())
 }
 
+func TestLine_Contains(t *testing.T) {
+	assert.True(t, lineInt.Contains(Pt(1, 2)))
+	assert.True(t, lineInt.Contains(Pt(3, 5)))
+	assert.False(t, lineInt.Contains(Pt(2, 4)))
+	assert.True(t, lineFloat.Contains(lineFloat.Midpoint()))
+	assert.False(t, lineFloat.Contains(Pt(0.0, 0.0)))
+}
+
 func TestLine_Int(t *testing.T) {
 	AssertLine(t, lineInt.Int(), 1, 2, 3, 5)
 	AssertLine(t, lineFloat.Int(), 1, 0, 1, 3)
@@ -0,0 +1,88 @@
+package geom
+
+import (
+	"math"
+)
+
+// JoinType determines how offset edges are connected at convex corners.
+type JoinType int
+
+const (
+	// JoinMiter extends offset edges until they meet, corners longer than miterLimit times distance are squared.
+	JoinMiter JoinType = iota
+	// JoinRound connects offset edges with circular arc.
+	JoinRound
+	// JoinSquare cuts corners perpendicular to their bisector at distance from the vertex.
+	JoinSquare
+)
+
+// miterLimit is the maximum distance of miter join from the vertex, relative to the offset distance.
+const miterLimit = 2
+
+// roundJoinStep is the maximum angle (in radians) of a single segment of round join.
+const roundJoinStep = math.Pi / 16
+
+// Offset creates a new MultiPolygon inflated (positive distance) or deflated (negative distance) by the given distance.
+// Deflating can split the polygon into multiple polygons or remove it entirely.
+func (p Polygon[T]) Offset(distance T, join JoinType) MultiPolygon[T] {
+	ring := removeCollinear(p.Float().NormalizeWinding(Clockwise).Vertices)
+	if len(ring) < 3 {
+		return MultiPolygon[T]{}
+	}
+
+	// offset ring contains loops with non-positive winding where it folds over itself
+	var vertices vertexTable
+	raw := vertices.snapRings([][]Point[float64]{offsetRing(ring, float64(distance), join)})[0]
+	rings := traceBoundary([][]Point[float64]{raw}, func(point Point[float64]) bool {
+		return windingNumber(raw, point) > 0
+	})
+
+	return multiPolygonFromRings[T](rings)
+}
+
+// offsetRing returns the ring (with positive signed area) with edges moved by distance outwards, connected by joins.
+// Offset edges at concave corners are connected through the vertex, so loops they form do not have positive winding.
+func offsetRing(ring []Point[float64], distance float64, join JoinType) []Point[float64] {
+	delta, side := math.Abs(distance), math.Copysign(1, distance)
+
+	raw := make([]Point[float64], 0, 3*len(ring))
+	for i, vertex := range ring {
+		prev, next := ring[(i+len(ring)-1)%len(ring)], ring[(i+1)%len(ring)]
+		direction1, direction2 := vertex.Subtract(prev).Normalize(), next.Subtract(vertex).Normalize()
+
+		// unit normals pointing to the offset side (inside is on the left)
+		normal1, normal2 := direction1.Normal().Multiply(-side), direction2.Normal().Multiply(-side)
+		start, end := vertex.Add(normal1.Multiply(delta)), vertex.Add(normal2.Multiply(delta))
+
+		if direction1.Cross(direction2)*distance <= 0 {
+			raw = append(raw, start, vertex, end)
+			continue
+		}
+
+		raw = append(raw, start)
+
+		cos := normal1.Dot(normal2)
+		switch join {
+		case JoinRound:
+			angle := math.Atan2(normal1.Cross(normal2), cos)
+			steps := int(math.Ceil(math.Abs(angle) / roundJoinStep))
+			for k := 1; k < steps; k++ {
+				raw = append(raw, vertex.Add(normal1.Rotate(angle*float64(k)/float64(steps)).Multiply(delta)))
+			}
+		case JoinMiter:
+			if 2/(1+cos) <= miterLimit*miterLimit {
+				raw = append(raw, vertex.Add(normal1.Add(normal2).Multiply(delta/(1+cos))))
+				break
+			}
+			fallthrough
+		case JoinSquare:
+			bisector := normal1.Add(normal2).Normalize()
+			extent := delta * (1 - normal1.Dot(bisector)) / direction1.Dot(bisector)
+			raw = append(raw, start.Add(direction1.Multiply(extent)), end.Add(direction2.Multiply(-extent)))
+		}
+
+		raw = append(raw, end)
+	}
+
+	return raw
+}
@@ -0,0 +1,91 @@
+package geom
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestPolygon_Offset(t *testing.T) {
+	square := RectFromMinMax(Pt(0, 0), Pt(4, 4)).Polygon()
+
+	inflated := square.Offset(1, JoinMiter)
+	assertMultiPolygon(t, inflated, 1, 0, 36)
+	AssertRect(t, inflated.Bounds(), 2, 2, 6, 6)
+
+	assertMultiPolygon(t, square.Offset(0, JoinMiter), 1, 0, 16)
+	assertMultiPolygon(t, square.Reverse().Offset(1, JoinMiter), 1, 0, 36)
+	assertMultiPolygon(t, square.Float().Offset(1, JoinSquare), 1, 0, 24+8*math.Sqrt2)
+	assertMultiPolygon(t, square.Float().Offset(1, JoinRound), 1, 0, 32+16*math.Sin(math.Pi/16))
+
+	// concave corner
+	shape := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(2.0, 0.0), Pt(2.0, 1.0), Pt(1.0, 1.0), Pt(1.0, 2.0), Pt(0.0, 2.0)})
+	assertMultiPolygon(t, shape.Offset(0.5, JoinMiter), 1, 0, 8)
+	assertMultiPolygon(t, shape.Offset(0.5, JoinRound), 1, 0, 6.75+5*math.Sin(math.Pi/16))
+
+	// sharp corner exceeding miter limit is squared
+	spike := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(10.0, 1.0), Pt(0.0, 2.0)})
+	assert.True(t, spike.Offset(1, JoinMiter).Bounds().Max().X < 12)
+	assert.True(t, spike.Offset(1, JoinMiter).Contains(Pt(10.9, 1.0)))
+
+	// gap closed by inflating creates a hole
+	frame := Pol([]Point[float64]{
+		Pt(0.0, 0.0), Pt(4.5, 0.0), Pt(4.5, 3.0), Pt(3.0, 3.0), Pt(3.0, 7.0), Pt(7.0, 7.0), Pt(7.0, 3.0), Pt(5.5, 3.0),
+		Pt(5.5, 0.0), Pt(10.0, 0.0), Pt(10.0, 10.0), Pt(0.0, 10.0),
+	})
+	assertMultiPolygon(t, frame.Offset(1, JoinMiter), 1, 1, 140)
+}
+
+func TestPolygon_OffsetDeflate(t *testing.T) {
+	square := RectFromMinMax(Pt(0, 0), Pt(4, 4)).Polygon()
+
+	deflated := square.Offset(-1, JoinMiter)
+	assertMultiPolygon(t, deflated, 1, 0, 4)
+	AssertRect(t, deflated.Bounds(), 2, 2, 2, 2)
+	assertMultiPolygon(t, square.Offset(-1, JoinRound), 1, 0, 4)
+
+	assert.True(t, square.Offset(-2, JoinMiter).Empty())
+	assert.True(t, square.Offset(-3, JoinRound).Empty())
+
+	// dumbbell splits in two
+	dumbbell := Pol([]Point[int]{
+		Pt(0, 0), Pt(4, 0), Pt(4, 1), Pt(6, 1), Pt(6, 0), Pt(10, 0), Pt(10, 4), Pt(6, 4), Pt(6, 3), Pt(4, 3), Pt(4, 4), Pt(0, 4),
+	})
+	assertMultiPolygon(t, dumbbell.Offset(-1, JoinMiter), 2, 0, 8)
+
+	// corners are not rounded when deflating convex polygon
+	frame := RectFromMinMax(Pt(0, 0), Pt(10, 10)).Polygon().Offset(-1, JoinSquare)
+	assertMultiPolygon(t, frame, 1, 0, 64)
+}
+
+func TestPolygon_OffsetRandom(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range 50 {
+		n := 3 + random.IntN(12)
+		vertices := make([]Point[float64], n)
+		for i := range vertices {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			vertices[i] = Pt(10.0, 10.0).Add(Vec(math.Cos(angle), math.Sin(angle)).Multiply(3 + random.Float64()*7))
+		}
+		polygon := Pol(vertices)
+
+		distance := random.Float64()*4 - 2
+		offset := polygon.Offset(distance, JoinRound)
+
+		// round joins approximate arcs by chords, so points close to the offset boundary are skipped
+		tolerance := 0.01 * math.Abs(distance)
+		for range 200 {
+			point := Pt(random.Float64()*30-5, random.Float64()*30-5)
+			signedDistance := polygon.SignedDistance(point)
+
+			if signedDistance < distance-tolerance {
+				assert.True(t, offset.Contains(point))
+			} else if signedDistance > distance+tolerance {
+				assert.False(t, offset.Contains(point))
+			}
+		}
+	}
+}
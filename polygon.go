@@ -3,6 +3,7 @@ package geom
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/gravitton/x/slices"
@@ -13,20 +14,72 @@ type Polygon[T Number] struct {
 	Vertices []Point[T]
 }
 
+// FillRule determines which points are inside a (self-intersecting) polygon.
+type FillRule int
+
+const (
+	// EvenOdd fills points crossed by odd number of edges.
+	EvenOdd FillRule = iota
+	// NonZero fills points with non-zero winding number.
+	NonZero
+)
+
+// Winding is the ordering direction of polygon vertices, as seen on screen with Y axis pointing down.
+type Winding int
+
+const (
+	// Clockwise winding has positive signed area.
+	Clockwise Winding = iota
+	// CounterClockwise winding has negative signed area.
+	CounterClockwise
+)
+
 // Pol is shorthand for Polygon{vertices}.
 func Pol[T Number](Vertices []Point[T]) Polygon[T] {
 	return Polygon[T]{Vertices}
 }
 
-// Center returns the polygon centroid computed as the average of its vertices.
+// Center returns the average of polygon vertices (computed in float64 and rounded once for integer polygons).
+// It differs from Centroid for polygons with unevenly distributed vertices.
 func (p Polygon[T]) Center() Point[T] {
-	var x, y T
-	l := T(len(p.Vertices))
+	if len(p.Vertices) == 0 {
+		return Point[T]{}
+	}
+
+	var x, y float64
 	for _, v := range p.Vertices {
-		x, y = x+v.X, y+v.Y
+		x, y = x+float64(v.X), y+float64(v.Y)
+	}
+
+	l := float64(len(p.Vertices))
+
+	return pointCast[T](Point[float64]{x / l, y / l})
+}
+
+// Centroid returns the polygon area-weighted centroid (center of mass), unlike Center it does not depend on
+// how vertices are distributed along edges. Polygons with zero area fall back to the average of vertices.
+func (p Polygon[T]) Centroid() Point[T] {
+	if len(p.Vertices) == 0 {
+		return Point[T]{}
+	}
+
+	vertices := p.Float().Vertices
+	origin := vertices[0]
+
+	var area, x, y float64
+	for i := range vertices {
+		v1, v2 := vertices[i].Subtract(origin), vertices[(i+1)%len(vertices)].Subtract(origin)
+		cross := v1.Cross(v2)
+		area += cross
+		x += (v1.X + v2.X) * cross
+		y += (v1.Y + v2.Y) * cross
+	}
+
+	if area == 0 {
+		return pointCast[T](p.Float().Center())
 	}
 
-	return Point[T]{x / l, y / l}
+	return pointCast[T](origin.AddXY(x/(3*area), y/(3*area)))
 }
 
 // Translate creates a new Polygon translated by the given vector (applied to all vertices).
@@ -36,6 +89,11 @@ func (p Polygon[T]) Translate(vector Vector[T]) Polygon[T] {
 	})}
 }
 
+// TranslateShape creates a new Polygon translated by the given vector as Shape.
+func (p Polygon[T]) TranslateShape(vector Vector[T]) Shape[T] {
+	return p.Translate(vector)
+}
+
 // MoveTo creates a new Polygon whose centroid is moved to point, preserving shape.
 func (p Polygon[T]) MoveTo(point Point[T]) Polygon[T] {
 	return p.Translate(point.Subtract(p.Center()))
@@ -57,6 +115,127 @@ func (p Polygon[T]) ScaleXY(factorX, factorY float64) Polygon[T] {
 	})}
 }
 
+// Bounds returns the axis-aligned bounding rectangle.
+func (p Polygon[T]) Bounds() Rectangle[T] {
+	if len(p.Vertices) == 0 {
+		return Rectangle[T]{}
+	}
+
+	minPoint, maxPoint := p.Vertices[0], p.Vertices[0]
+	for _, v := range p.Vertices[1:] {
+		minPoint = Point[T]{min(minPoint.X, v.X), min(minPoint.Y, v.Y)}
+		maxPoint = Point[T]{max(maxPoint.X, v.X), max(maxPoint.Y, v.Y)}
+	}
+
+	return RectFromMinMax(minPoint, maxPoint)
+}
+
+// SignedArea returns the polygon area computed by the shoelace formula.
+// It is positive for clockwise and negative for counter-clockwise winding.
+func (p Polygon[T]) SignedArea() float64 {
+	return signedArea(p.Float().Vertices)
+}
+
+// Area returns the polygon area.
+func (p Polygon[T]) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+// Perimeter returns the polygon perimeter.
+func (p Polygon[T]) Perimeter() float64 {
+	var perimeter float64
+	for i, v := range p.Vertices {
+		perimeter += v.DistanceTo(p.Vertices[(i+1)%len(p.Vertices)])
+	}
+
+	return perimeter
+}
+
+// Winding returns the vertices winding, polygons with zero area are counter-clockwise.
+func (p Polygon[T]) Winding() Winding {
+	if p.IsClockwise() {
+		return Clockwise
+	}
+
+	return CounterClockwise
+}
+
+// IsClockwise checks if the vertices are in clockwise order.
+func (p Polygon[T]) IsClockwise() bool {
+	return p.SignedArea() > 0
+}
+
+// IsConvex checks if the polygon is convex and not self-intersecting, collinear and duplicate vertices are allowed.
+func (p Polygon[T]) IsConvex() bool {
+	vertices := p.Float().Vertices
+	n := len(vertices)
+
+	var sign, turning float64
+	for i, vertex := range vertices {
+		edge1, edge2 := vertex.Subtract(vertices[(i+n-1)%n]), vertices[(i+1)%n].Subtract(vertex)
+		cross := edge1.Cross(edge2)
+		if cross == 0 {
+			continue
+		}
+		if sign*cross < 0 {
+			return false
+		}
+
+		sign = cross
+		turning += math.Atan2(cross, edge1.Dot(edge2))
+	}
+
+	// self-intersecting polygon turning always to the same side winds around more than once
+	return math.Abs(turning) < 2*math.Pi+Delta
+}
+
+// Reverse creates a new Polygon with vertices in reversed order.
+func (p Polygon[T]) Reverse() Polygon[T] {
+	vertices := make([]Point[T], len(p.Vertices))
+	for i, v := range p.Vertices {
+		vertices[len(vertices)-1-i] = v
+	}
+
+	return Polygon[T]{vertices}
+}
+
+// NormalizeWinding creates a new Polygon with vertices in the given winding order.
+func (p Polygon[T]) NormalizeWinding(winding Winding) Polygon[T] {
+	if p.Winding() == winding {
+		return Polygon[T]{append([]Point[T](nil), p.Vertices...)}
+	}
+
+	return p.Reverse()
+}
+
+// ConvexHull creates the smallest convex Polygon containing the polygon.
+func (p Polygon[T]) ConvexHull() Polygon[T] {
+	return ConvexHull(p.Vertices)
+}
+
+// ClosestPoint returns the point on the polygon boundary nearest to the given point.
+func (p Polygon[T]) ClosestPoint(point Point[T]) Point[T] {
+	if len(p.Vertices) == 0 {
+		return point
+	}
+
+	return pointCast[T](closestBoundary(p.Float().Vertices, point.Float()))
+}
+
+// SignedDistance returns distance from the polygon boundary to the given point, negative inside the polygon.
+func (p Polygon[T]) SignedDistance(point Point[T]) float64 {
+	if len(p.Vertices) == 0 {
+		return math.Inf(1)
+	}
+
+	return signedDistance(p.Float().Vertices, point.Float())
+}
+
+// Support returns the farthest vertex of the polygon (its convex hull) in the given direction.
+func (p Polygon[T]) Support(direction Vector[float64]) Point[float64] {
+	return supportVertices(p.Float().Vertices, direction)
+}
+
 // Equal checks if two polygons have the same vertices.
 func (p Polygon[T]) Equal(polygon Polygon[T]) bool {
 	if len(p.Vertices) != len(polygon.Vertices) {
@@ -82,6 +261,43 @@ func (p Polygon[T]) Empty() bool {
 	return len(p.Vertices) == 0
 }
 
+// CastRay casts the given ray against the polygon (same as Ray.CastPolygon).
+func (p Polygon[T]) CastRay(ray Ray[T]) (RayHit[T], bool) {
+	return ray.CastPolygon(p)
+}
+
+// Contains checks if the given point lies inside the polygon (using even-odd rule) or on its boundary.
+func (p Polygon[T]) Contains(point Point[T]) bool {
+	return polygonContains(p.Float().Vertices, point.Float())
+}
+
+// ContainsRule checks if the given point lies inside the polygon (using the given fill rule) or on its boundary.
+func (p Polygon[T]) ContainsRule(point Point[T], rule FillRule) bool {
+	vertices, pt := p.Float().Vertices, point.Float()
+
+	switch rule {
+	case NonZero:
+		return polygonOnBoundary(vertices, pt) || windingNumber(vertices, pt) != 0
+	default:
+		return polygonContains(vertices, pt)
+	}
+}
+
+// ContainsConvex checks if the given point lies inside the polygon or on its boundary.
+// Convex polygons (verified by IsConvex) are searched in O(log n) time, other polygons fall back to Contains.
+func (p Polygon[T]) ContainsConvex(point Point[T]) bool {
+	if !p.IsConvex() {
+		return p.Contains(point)
+	}
+
+	return convexContains(p.Float().Vertices, point.Float())
+}
+
+// WindingNumber returns how many times the polygon winds around the given point.
+func (p Polygon[T]) WindingNumber(point Point[T]) int {
+	return windingNumber(p.Float().Vertices, point.Float())
+}
+
 // Int converts the polygon to a [int] polygon.
 func (p Polygon[T]) Int() Polygon[int] {
 	return Polygon[int]{slices.Map(p.Vertices, Point[T].Int)}
@@ -106,3 +322,132 @@ func (p Polygon[T]) MarshalJSON() ([]byte, error) {
 func (p Polygon[T]) UnmarshalJSON(bytes []byte) error {
 	return json.Unmarshal(bytes, &p.Vertices)
 }
+
+// signedArea returns area computed by the shoelace formula, positive for clockwise winding.
+func signedArea(vertices []Point[float64]) float64 {
+	var area float64
+	for i, v := range vertices {
+		area += v.Vector().Cross(vertices[(i+1)%len(vertices)].Vector())
+	}
+
+	return area / 2
+}
+
+// polygonContains checks if point lies inside the polygon (even-odd rule) or on its boundary.
+func polygonContains(vertices []Point[float64], point Point[float64]) bool {
+	return polygonOnBoundary(vertices, point) || crossingNumber(vertices, point)%2 == 1
+}
+
+// polygonOnBoundary checks if point lies on any polygon edge.
+func polygonOnBoundary(vertices []Point[float64], point Point[float64]) bool {
+	for i, vertex := range vertices {
+		if projectSegment(vertex, vertices[(i+1)%len(vertices)], point).DistanceSquaredTo(point) <= Delta*Delta {
+			return true
+		}
+	}
+
+	return false
+}
+
+// crossingNumber returns number of polygon edges crossed by horizontal ray from point to +X.
+func crossingNumber(vertices []Point[float64], point Point[float64]) int {
+	crossings := 0
+	for i, j := 0, len(vertices)-1; i < len(vertices); j, i = i, i+1 {
+		a, b := vertices[j], vertices[i]
+		if (a.Y > point.Y) != (b.Y > point.Y) && point.X < a.X+(point.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			crossings++
+		}
+	}
+
+	return crossings
+}
+
+// windingNumber returns how many times polygon winds around the point (positive for positive signed area).
+func windingNumber(vertices []Point[float64], point Point[float64]) int {
+	winding := 0
+	for i, j := 0, len(vertices)-1; i < len(vertices); j, i = i, i+1 {
+		a, b := vertices[j], vertices[i]
+		side := b.Subtract(a).Cross(point.Subtract(a))
+
+		if a.Y <= point.Y {
+			if b.Y > point.Y && side > 0 {
+				winding++
+			}
+		} else if b.Y <= point.Y && side < 0 {
+			winding--
+		}
+	}
+
+	return winding
+}
+
+// convexContains checks if point lies inside the convex polygon or on its boundary using binary search (O(log n)).
+func convexContains(vertices []Point[float64], point Point[float64]) bool {
+	n := len(vertices)
+	if n < 3 {
+		return polygonOnBoundary(vertices, point)
+	}
+
+	pivot := vertices[0]
+	offset := point.Subtract(pivot)
+
+	// orientation of vertices
+	sign := math.Copysign(1, vertices[1].Subtract(pivot).Cross(vertices[n-1].Subtract(pivot)))
+	side := func(vertex Point[float64]) float64 {
+		return sign * vertex.Subtract(pivot).Cross(offset)
+	}
+
+	// outside of the angle formed by first and last edge
+	if side(vertices[1]) < -Delta || side(vertices[n-1]) > Delta {
+		return false
+	}
+
+	// find wedge (pivot, vertices[low], vertices[high]) containing the point
+	low, high := 1, n-1
+	for high-low > 1 {
+		middle := (low + high) / 2
+		if side(vertices[middle]) >= 0 {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return sign*vertices[high].Subtract(vertices[low]).Cross(point.Subtract(vertices[low])) >= -Delta
+}
+
+// supportVertices returns the vertex farthest in the given direction.
+func supportVertices(vertices []Point[float64], direction Vector[float64]) Point[float64] {
+	var support Point[float64]
+	best := math.Inf(-1)
+	for _, vertex := range vertices {
+		if projection := vertex.Vector().Dot(direction); projection > best {
+			support, best = vertex, projection
+		}
+	}
+
+	return support
+}
+
+// closestBoundary returns the point on the polygon edges nearest to the given point.
+func closestBoundary(vertices []Point[float64], point Point[float64]) Point[float64] {
+	closest := vertices[0]
+	for i, vertex := range vertices {
+		candidate := projectSegment(vertex, vertices[(i+1)%len(vertices)], point)
+		if candidate.DistanceSquaredTo(point) < closest.DistanceSquaredTo(point) {
+			closest = candidate
+		}
+	}
+
+	return closest
+}
+
+// signedDistance returns distance from the polygon edges to the given point, negative inside the polygon.
+func signedDistance(vertices []Point[float64], point Point[float64]) float64 {
+	distance := closestBoundary(vertices, point).DistanceTo(point)
+	if polygonContains(vertices, point) {
+		return -distance
+	}
+
+	return distance
+}
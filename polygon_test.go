@@ -2,6 +2,8 @@ package geom
 
 import (
 	"encoding/json"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/gravitton/assert"
@@ -20,6 +22,31 @@ func TestPolygon_New(t *testing.T) {
 func TestPolygon_Center(t *testing.T) {
 	AssertPoint(t, polygonInt.Center(), 1, 1)
 	AssertPoint(t, polygonFloat.Center(), 1.5, 0.5)
+	// integer average is rounded, not truncated
+	AssertPoint(t, Pol([]Point[int]{{0, 0}, {2, 0}, {2, 2}}).Center(), 1, 1)
+	AssertPoint(t, Pol([]Point[int]{{0, 0}, {-2, 0}, {-2, -2}}).Center(), -1, -1)
+	// extra vertex on an edge moves the average of vertices, not the area centroid
+	AssertPoint(t, Pol([]Point[float64]{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 2}}).Center(), 1.0, 0.8)
+	AssertPoint(t, Pol([]Point[float64]{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {0, 2}}).Centroid(), 1.0, 1.0)
+	AssertPoint(t, Polygon[int]{}.Center(), 0, 0)
+}
+
+func TestPolygon_Centroid(t *testing.T) {
+	AssertPoint(t, polygonInt.Centroid(), 1, 1)
+	AssertPoint(t, polygonFloat.Centroid(), 1.5, 0.5)
+
+	// vertices concentrated on one side do not shift the centroid
+	square := Pol([]Point[float64]{Pt(0.0, 0.0), Pt(1.0, 0.0), Pt(2.0, 0.0), Pt(3.0, 0.0), Pt(4.0, 0.0), Pt(4.0, 4.0), Pt(0.0, 4.0)})
+	AssertPoint(t, square.Centroid(), 2, 2)
+	AssertPoint(t, square.Reverse().Centroid(), 2, 2)
+
+	// L-shape with integer rounding
+	shape := Pol([]Point[int]{Pt(0, 0), Pt(6, 0), Pt(6, 2), Pt(2, 2), Pt(2, 6), Pt(0, 6)})
+	AssertPoint(t, shape.Centroid(), 2, 2)
+
+	// degenerate polygon
+	AssertPoint(t, Pol([]Point[float64]{Pt(0.0, 0.0), Pt(1.0, 1.0), Pt(2.0, 2.0)}).Centroid(), 1, 1)
+	AssertPoint(t, Polygon[int]{}.Centroid(), 0, 0)
 }
 
 func TestPolygon_Translate(t *testing.T) {
@@ -54,6 +81,82 @@ func TestPolygon_Scale(t *testing.T) {
 	})
 }
 
+func TestPolygon_Bounds(t *testing.T) {
+	AssertRect(t, polygonInt.Bounds(), 1, 1, 2, 2)
+	AssertRect(t, polygonFloat.Bounds(), 1.25, 0.5, 2.5, 1)
+	AssertRect(t, Polygon[int]{}.Bounds(), 0, 0, 0, 0)
+}
+
+func TestPolygon_Area(t *testing.T) {
+	assert.Equal(t, polygonInt.SignedArea(), 4.0)
+	assert.Equal(t, polygonInt.Reverse().SignedArea(), -4.0)
+	assert.Equal(t, polygonInt.Reverse().Area(), 4.0)
+	assert.EqualDelta(t, polygonFloat.Area(), 0.75, 1e-9)
+	assert.Equal(t, Pol([]Point[int]{Pt(0, 0), Pt(1, 0), Pt(0, 1)}).Area(), 0.5)
+	assert.Equal(t, RectFromMinMax(Pt(0, 0), Pt(3, 2)).Polygon().Area(), 6.0)
+}
+
+func TestPolygon_Perimeter(t *testing.T) {
+	assert.Equal(t, polygonInt.Perimeter(), 8.0)
+	assert.EqualDelta(t, Pol([]Point[float64]{Pt(0.0, 0.0), Pt(3.0, 0.0), Pt(0.0, 4.0)}).Perimeter(), 12.0, 1e-9)
+}
+
+func TestPolygon_Winding(t *testing.T) {
+	assert.True(t, polygonInt.IsClockwise())
+	assert.Equal(t, polygonInt.Winding(), Clockwise)
+	assert.False(t, polygonInt.Reverse().IsClockwise())
+	assert.Equal(t, polygonInt.Reverse().Winding(), CounterClockwise)
+	// rectangle vertices are counter-clockwise
+	assert.Equal(t, RectFromMinMax(Pt(0, 0), Pt(3, 2)).Polygon().Winding(), CounterClockwise)
+}
+
+func TestPolygon_IsConvex(t *testing.T) {
+	assert.True(t, polygonInt.IsConvex())
+	assert.True(t, polygonInt.Reverse().IsConvex())
+	assert.True(t, Pol([]Point[int]{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {2, 2}, {0, 2}}).IsConvex())
+	assert.True(t, regPolygonInt.Polygon().IsConvex())
+	assert.False(t, Pol([]Point[int]{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}}).IsConvex())
+	// pentagram and square wound twice turn to the same side at every vertex
+	assert.False(t, Pol([]Point[int]{{0, -10}, {6, 8}, {-10, -3}, {10, -3}, {-6, 8}}).IsConvex())
+	assert.False(t, Pol([]Point[int]{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}, {2, 0}, {2, 2}, {0, 2}}).IsConvex())
+}
+
+func TestPolygon_Reverse(t *testing.T) {
+	AssertPolygon(t, polygonInt.Reverse(), []Point[int]{Pt(0, 2), Pt(2, 2), Pt(2, 0), Pt(0, 0)})
+}
+
+func TestPolygon_NormalizeWinding(t *testing.T) {
+	AssertPolygon(t, polygonInt.NormalizeWinding(Clockwise), polygonInt.Vertices)
+	AssertPolygon(t, polygonInt.NormalizeWinding(CounterClockwise), polygonInt.Reverse().Vertices)
+	AssertPolygon(t, polygonInt.Reverse().NormalizeWinding(Clockwise), polygonInt.Vertices)
+}
+
+func TestPolygon_ClosestPoint(t *testing.T) {
+	AssertPoint(t, polygonInt.ClosestPoint(Pt(5, 1)), 2, 1)
+	AssertPoint(t, polygonInt.ClosestPoint(Pt(-1, -1)), 0, 0)
+	AssertPoint(t, polygonInt.ClosestPoint(Pt(1, 0)), 1, 0)
+
+	triangle := Pol([]Point[float64]{{0, 0}, {4, 0}, {0, 4}})
+	AssertPoint(t, triangle.ClosestPoint(Pt(3.0, 3.0)), 2, 2)
+	AssertPoint(t, triangle.ClosestPoint(Pt(1.0, 0.5)), 1, 0)
+}
+
+func TestPolygon_SignedDistance(t *testing.T) {
+	assert.EqualDelta(t, polygonInt.SignedDistance(Pt(5, 1)), 3.0, Delta)
+	assert.EqualDelta(t, polygonInt.SignedDistance(Pt(1, 1)), -1.0, Delta)
+	assert.EqualDelta(t, polygonInt.SignedDistance(Pt(2, 1)), 0.0, Delta)
+
+	triangle := Pol([]Point[float64]{{0, 0}, {4, 0}, {0, 4}})
+	assert.EqualDelta(t, triangle.SignedDistance(Pt(3.0, 3.0)), math.Sqrt2, Delta)
+	assert.EqualDelta(t, triangle.SignedDistance(Pt(1.0, 0.5)), -0.5, Delta)
+}
+
+func TestPolygon_Support(t *testing.T) {
+	AssertPoint(t, polygonInt.Support(Vec(1.0, 1.0)), 2, 2)
+	AssertPoint(t, polygonInt.Support(Vec(-1.0, 1.0)), 0, 2)
+	AssertPoint(t, polygonFloat.Support(Vec(1.0, 0.0)), 2.5, 0.5)
+}
+
 func TestPolygon_Equal(t *testing.T) {
 	assert.True(t, polygonInt.Equal(polygonInt))
 	assert.False(t, polygonInt.Equal(Pol([]Point[int]{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {3, 2}})))
@@ -74,6 +177,62 @@ func TestPolygon_Empty(t *testing.T) {
 	assert.False(t, polygonFloat.Empty())
 }
 
+func TestPolygon_Contains(t *testing.T) {
+	assert.True(t, polygonInt.Contains(Pt(1, 1)))
+	assert.True(t, polygonInt.Contains(Pt(2, 1)))
+	assert.True(t, polygonInt.Contains(Pt(0, 0)))
+	assert.False(t, polygonInt.Contains(Pt(3, 1)))
+
+	assert.True(t, polygonFloat.Contains(Pt(1.5, 0.5)))
+	assert.False(t, polygonFloat.Contains(Pt(0.5, 0.5)))
+}
+
+func TestPolygon_ContainsRule(t *testing.T) {
+	pentagram := Pol([]Point[float64]{{0, -10}, {5.878, 8.09}, {-9.511, -3.09}, {9.511, -3.09}, {-5.878, 8.09}})
+
+	assert.False(t, pentagram.ContainsRule(Pt(0.0, 0.0), EvenOdd))
+	assert.True(t, pentagram.ContainsRule(Pt(0.0, 0.0), NonZero))
+	assert.True(t, pentagram.ContainsRule(Pt(0.0, -6.0), EvenOdd))
+	assert.True(t, pentagram.ContainsRule(Pt(0.0, -6.0), NonZero))
+	assert.False(t, pentagram.ContainsRule(Pt(0.0, 9.0), NonZero))
+	assert.True(t, pentagram.ContainsRule(Pt(0.0, -10.0), NonZero))
+
+	assert.True(t, polygonInt.ContainsRule(Pt(1, 1), NonZero))
+	assert.False(t, polygonInt.ContainsRule(Pt(3, 1), NonZero))
+}
+
+func TestPolygon_ContainsConvex(t *testing.T) {
+	assert.True(t, polygonInt.ContainsConvex(Pt(1, 1)))
+	assert.True(t, polygonInt.ContainsConvex(Pt(2, 1)))
+	assert.True(t, polygonInt.ContainsConvex(Pt(0, 0)))
+	assert.False(t, polygonInt.ContainsConvex(Pt(3, 1)))
+	assert.False(t, polygonInt.ContainsConvex(Pt(1, -1)))
+
+	octagon := RegPol(Pt(0.0, 0.0), SzU(10.0), 8, 0).Polygon()
+	reversed := Pol(slices.Clone(octagon.Vertices))
+	slices.Reverse(reversed.Vertices)
+
+	for _, point := range []Point[float64]{{0, 0}, {9, 0}, {6, 6}, {7, 7}, {-9.9, 0}, {0, -10}, {0, 10.1}, {-7, 7.5}} {
+		assert.Equal(t, octagon.ContainsConvex(point), octagon.Contains(point), point.String())
+		assert.Equal(t, reversed.ContainsConvex(point), octagon.Contains(point), point.String())
+	}
+
+	// binary search over wedges of the concave U shape misses its right leg
+	concave := Pol([]Point[float64]{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}})
+	assert.False(t, convexContains(concave.Vertices, Pt(2.5, 2.0)))
+	assert.True(t, concave.ContainsConvex(Pt(2.5, 2.0)))
+	assert.False(t, concave.ContainsConvex(Pt(1.5, 2.0)))
+}
+
+func TestPolygon_WindingNumber(t *testing.T) {
+	assert.Equal(t, polygonInt.WindingNumber(Pt(1, 1)), 1)
+	assert.Equal(t, polygonInt.WindingNumber(Pt(3, 1)), 0)
+	assert.Equal(t, Pol([]Point[int]{{0, 0}, {0, 2}, {2, 2}, {2, 0}}).WindingNumber(Pt(1, 1)), -1)
+
+	twice := Pol([]Point[int]{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}, {2, 0}, {2, 2}, {0, 2}})
+	assert.Equal(t, twice.WindingNumber(Pt(1, 1)), 2)
+}
+
 func TestPolygon_Int(t *testing.T) {
 	AssertPolygon(t, polygonInt.Int(), []Point[int]{
 		Pt(0, 0),
@@ -0,0 +1,229 @@
+// Package quadtree provides a generic quadtree spatial index of items keyed by their rectangle bounds.
+package quadtree
+
+import (
+	"iter"
+	"slices"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Quadtree is a spatial index of items keyed by their rectangle bounds.
+// Items are stored in the deepest node fully containing their bounds, items outside of tree bounds are stored in the root.
+type Quadtree[T geom.Number, V comparable] struct {
+	root     *node[T, V]
+	items    map[V]geom.Rectangle[T]
+	capacity int
+	maxDepth int
+}
+
+// node is a quadtree node with items not fitting into any of its children.
+type node[T geom.Number, V comparable] struct {
+	bounds   geom.Rectangle[T]
+	entries  []entry[T, V]
+	children *[4]node[T, V]
+}
+
+// entry is an item with its bounds.
+type entry[T geom.Number, V comparable] struct {
+	item   V
+	bounds geom.Rectangle[T]
+}
+
+// New creates an empty Quadtree covering the given bounds.
+// Node is split into quadrants when it holds more than capacity items, until maximum depth is reached.
+// Capacity less than 1 is replaced by 1 and negative maximum depth by 0.
+func New[T geom.Number, V comparable](bounds geom.Rectangle[T], capacity, maxDepth int) *Quadtree[T, V] {
+	return &Quadtree[T, V]{
+		root:     &node[T, V]{bounds: bounds},
+		items:    make(map[V]geom.Rectangle[T]),
+		capacity: max(capacity, 1),
+		maxDepth: max(maxDepth, 0),
+	}
+}
+
+// Insert adds the item with the given bounds, already present item is moved to new bounds.
+func (q *Quadtree[T, V]) Insert(item V, bounds geom.Rectangle[T]) {
+	q.Remove(item)
+
+	q.items[item] = bounds
+	q.root.insert(entry[T, V]{item, bounds}, 0, q.capacity, q.maxDepth)
+}
+
+// Remove removes the item, returns false if item is not present.
+func (q *Quadtree[T, V]) Remove(item V) bool {
+	bounds, ok := q.items[item]
+	if !ok {
+		return false
+	}
+
+	delete(q.items, item)
+	q.root.remove(entry[T, V]{item, bounds}, q.capacity)
+
+	return true
+}
+
+// Update moves the item to new bounds, returns false if item is not present.
+func (q *Quadtree[T, V]) Update(item V, bounds geom.Rectangle[T]) bool {
+	if _, ok := q.items[item]; !ok {
+		return false
+	}
+
+	q.Insert(item, bounds)
+
+	return true
+}
+
+// Bounds returns bounds of the item.
+func (q *Quadtree[T, V]) Bounds(item V) (geom.Rectangle[T], bool) {
+	bounds, ok := q.items[item]
+
+	return bounds, ok
+}
+
+// Len returns number of items.
+func (q *Quadtree[T, V]) Len() int {
+	return len(q.items)
+}
+
+// Clear removes all items.
+func (q *Quadtree[T, V]) Clear() {
+	q.root = &node[T, V]{bounds: q.root.bounds}
+	clear(q.items)
+}
+
+// QueryRect returns an iterator over items whose bounds collide with the given rectangle.
+func (q *Quadtree[T, V]) QueryRect(rect geom.Rectangle[T]) iter.Seq[V] {
+	return q.query(func(bounds geom.Rectangle[T]) bool {
+		return geom.CollisionRectangles(bounds, rect)
+	})
+}
+
+// QueryCircle returns an iterator over items whose bounds collide with the given circle.
+func (q *Quadtree[T, V]) QueryCircle(circle geom.Circle[T]) iter.Seq[V] {
+	return q.query(func(bounds geom.Rectangle[T]) bool {
+		return geom.CollisionRectangleCircle(bounds, circle)
+	})
+}
+
+// QueryPoint returns an iterator over items whose bounds contain the given point.
+func (q *Quadtree[T, V]) QueryPoint(point geom.Point[T]) iter.Seq[V] {
+	return q.query(func(bounds geom.Rectangle[T]) bool {
+		return bounds.Contains(point)
+	})
+}
+
+// query returns an iterator over items whose bounds pass the test, test must pass for any rectangle enclosing passing bounds.
+func (q *Quadtree[T, V]) query(test func(bounds geom.Rectangle[T]) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		q.root.query(test, yield)
+	}
+}
+
+// insert adds entry to the deepest node fully containing its bounds, splitting full leaves.
+func (n *node[T, V]) insert(e entry[T, V], depth, capacity, maxDepth int) {
+	if n.children != nil {
+		if i := n.childIndex(e.bounds); i >= 0 {
+			n.children[i].insert(e, depth+1, capacity, maxDepth)
+			return
+		}
+	}
+
+	n.entries = append(n.entries, e)
+
+	if n.children == nil && len(n.entries) > capacity && depth < maxDepth {
+		n.split()
+
+		entries := n.entries
+		n.entries = nil
+		for _, e := range entries {
+			n.insert(e, depth, capacity, maxDepth)
+		}
+	}
+}
+
+// remove removes entry from the node or its children, merging children back when they become sparse.
+func (n *node[T, V]) remove(e entry[T, V], capacity int) {
+	if n.children != nil {
+		if i := n.childIndex(e.bounds); i >= 0 {
+			n.children[i].remove(e, capacity)
+			n.merge(capacity)
+			return
+		}
+	}
+
+	if i := slices.IndexFunc(n.entries, func(other entry[T, V]) bool { return other.item == e.item }); i >= 0 {
+		n.entries = slices.Delete(n.entries, i, i+1)
+	}
+}
+
+// query yields items from the node and children whose bounds pass the test.
+func (n *node[T, V]) query(test func(bounds geom.Rectangle[T]) bool, yield func(V) bool) bool {
+	for _, e := range n.entries {
+		if test(e.bounds) && !yield(e.item) {
+			return false
+		}
+	}
+
+	if n.children != nil {
+		for i := range n.children {
+			child := &n.children[i]
+			if test(child.bounds) && !child.query(test, yield) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// split creates four quadrant children.
+func (n *node[T, V]) split() {
+	minPoint, maxPoint, center := n.bounds.Min(), n.bounds.Max(), n.bounds.Center
+
+	n.children = &[4]node[T, V]{
+		{bounds: geom.RectFromMinMax(minPoint, center)},
+		{bounds: geom.RectFromMinMax(geom.Pt(center.X, minPoint.Y), geom.Pt(maxPoint.X, center.Y))},
+		{bounds: geom.RectFromMinMax(geom.Pt(minPoint.X, center.Y), geom.Pt(center.X, maxPoint.Y))},
+		{bounds: geom.RectFromMinMax(center, maxPoint)},
+	}
+}
+
+// merge moves entries of leaf children back to the node if they fit into its capacity.
+func (n *node[T, V]) merge(capacity int) {
+	count := len(n.entries)
+	for i := range n.children {
+		if n.children[i].children != nil {
+			return
+		}
+		count += len(n.children[i].entries)
+	}
+
+	if count > capacity {
+		return
+	}
+
+	for i := range n.children {
+		n.entries = append(n.entries, n.children[i].entries...)
+	}
+	n.children = nil
+}
+
+// childIndex returns index of the child fully containing the given bounds, or -1.
+func (n *node[T, V]) childIndex(bounds geom.Rectangle[T]) int {
+	for i := range n.children {
+		if containsRect(n.children[i].bounds, bounds) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// containsRect checks if the outer rectangle fully contains the inner rectangle.
+func containsRect[T geom.Number](outer, inner geom.Rectangle[T]) bool {
+	outerMin, outerMax := outer.Min(), outer.Max()
+	innerMin, innerMax := inner.Min(), inner.Max()
+
+	return outerMin.X <= innerMin.X && outerMin.Y <= innerMin.Y && innerMax.X <= outerMax.X && innerMax.Y <= outerMax.Y
+}
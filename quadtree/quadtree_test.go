@@ -0,0 +1,158 @@
+package quadtree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+var (
+	treeBounds = geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(100, 100))
+	// items 0-2 and 4 fit into quadrants, item 3 straddles the center
+	items = []geom.Rectangle[int]{
+		geom.RectFromMinMax(geom.Pt(10, 10), geom.Pt(20, 20)),
+		geom.RectFromMinMax(geom.Pt(60, 10), geom.Pt(70, 20)),
+		geom.RectFromMinMax(geom.Pt(10, 60), geom.Pt(20, 70)),
+		geom.RectFromMinMax(geom.Pt(45, 45), geom.Pt(55, 55)),
+		geom.RectFromMinMax(geom.Pt(80, 80), geom.Pt(90, 90)),
+	}
+)
+
+func TestNew(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 4)
+	assert.Equal(t, tree.Len(), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(treeBounds))), 0)
+	assert.False(t, tree.Remove(0))
+
+	_, ok := tree.Bounds(0)
+	assert.False(t, ok)
+
+	// invalid capacity and depth are clamped, so the root is never split
+	tree = New[int, int](treeBounds, 0, -1)
+	assert.Equal(t, tree.capacity, 1)
+	assert.Equal(t, tree.maxDepth, 0)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+	assert.True(t, tree.root.children == nil)
+	assert.Equal(t, len(tree.root.entries), 5)
+}
+
+func TestQuadtree_Insert(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 4)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.Equal(t, tree.Len(), 5)
+	assert.True(t, tree.root.children != nil)
+	// straddling item stays in the root
+	assert.Equal(t, len(tree.root.entries), 1)
+	assert.Equal(t, tree.root.entries[0].item, 3)
+
+	// items outside of tree bounds are kept in the root
+	tree.Insert(5, geom.RectFromMinMax(geom.Pt(150, 150), geom.Pt(160, 160)))
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(155, 155))), []int{5})
+
+	// reinsert moves the item
+	tree.Insert(0, geom.RectFromMinMax(geom.Pt(30, 30), geom.Pt(36, 36)))
+	assert.Equal(t, tree.Len(), 6)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(15, 15)))), 0)
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(33, 33))), []int{0})
+}
+
+func TestQuadtree_InsertBoundary(t *testing.T) {
+	tree := New[int, int](treeBounds, 1, 4)
+	tree.Insert(0, geom.RectFromMinMax(geom.Pt(40, 10), geom.Pt(50, 20)))
+	tree.Insert(1, geom.Rect(geom.Pt(50, 50), geom.Sz(0, 0)))
+	tree.Insert(2, geom.RectFromMinMax(geom.Pt(50, 60), geom.Pt(60, 70)))
+
+	assert.True(t, tree.root.children != nil)
+	// items touching quadrant edges are found from both sides of the edge
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(50, 15))), []int{0})
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(50, 50))), []int{1})
+	assert.Equal(t, slices.Collect(tree.QueryRect(geom.Rect(geom.Pt(50, 65), geom.Sz(0, 0)))), []int{2})
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(50, 20), geom.Pt(60, 60)))), []int{0, 1, 2})
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(51, 15)))), 0)
+
+	assert.True(t, tree.Remove(1))
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(50, 50)))), 0)
+}
+
+func TestQuadtree_MaxDepth(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 2)
+	for i := range 10 {
+		tree.Insert(i, geom.RectFromMinMax(geom.Pt(2, 2), geom.Pt(4, 4)))
+	}
+
+	leaf := &tree.root.children[0].children[0]
+	assert.True(t, leaf.children == nil)
+	assert.Equal(t, len(leaf.entries), 10)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(3, 3)))), 10)
+}
+
+func TestQuadtree_Remove(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 4)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.True(t, tree.Remove(1))
+	assert.False(t, tree.Remove(1))
+	assert.Equal(t, tree.Len(), 4)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(65, 15)))), 0)
+
+	assert.True(t, tree.Remove(2))
+	assert.True(t, tree.Remove(4))
+	// sparse children are merged back
+	assert.True(t, tree.root.children == nil)
+	assert.Equal(t, slices.Sorted(tree.QueryRect(treeBounds)), []int{0, 3})
+
+	// removed item can be inserted again
+	tree.Insert(1, items[1])
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(65, 15))), []int{1})
+}
+
+func TestQuadtree_Update(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 4)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.True(t, tree.Update(0, geom.RectFromMinMax(geom.Pt(80, 10), geom.Pt(86, 16))))
+	assert.False(t, tree.Update(7, geom.RectFromMinMax(geom.Pt(80, 10), geom.Pt(86, 16))))
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(82, 12))), []int{0})
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(15, 15)))), 0)
+
+	bounds, ok := tree.Bounds(0)
+	assert.True(t, ok)
+	assert.True(t, bounds.Equal(geom.RectFromMinMax(geom.Pt(80, 10), geom.Pt(86, 16))))
+}
+
+func TestQuadtree_Query(t *testing.T) {
+	tree := New[int, int](treeBounds, 2, 4)
+	for i, bounds := range items {
+		tree.Insert(i, bounds)
+	}
+
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(50, 50)))), []int{0, 3})
+	assert.Equal(t, slices.Sorted(tree.QueryRect(geom.RectFromMinMax(geom.Pt(20, 20), geom.Pt(44, 44)))), []int{0})
+	assert.Equal(t, slices.Sorted(tree.QueryRect(treeBounds)), []int{0, 1, 2, 3, 4})
+	assert.Equal(t, slices.Sorted(tree.QueryCircle(geom.Circ(geom.Pt(15, 40), 21))), []int{0, 2})
+	assert.Equal(t, slices.Sorted(tree.QueryPoint(geom.Pt(50, 50))), []int{3})
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(30, 30)))), 0)
+
+	// iteration stops when requested
+	count := 0
+	for range tree.QueryRect(treeBounds) {
+		count++
+		break
+	}
+	assert.Equal(t, count, 1)
+
+	tree.Clear()
+	assert.Equal(t, tree.Len(), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(50, 50)))), 0)
+}
@@ -0,0 +1,260 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Ray is a 2D half-line starting at origin and going in direction.
+type Ray[T Number] struct {
+	Origin    Point[T]  `json:"o"`
+	Direction Vector[T] `json:"d"`
+}
+
+// Ry is shorthand for Ray{origin, direction}.
+func Ry[T Number](origin Point[T], direction Vector[T]) Ray[T] {
+	return Ray[T]{origin, direction}
+}
+
+// RayHit is a result of a ray cast.
+type RayHit[T Number] struct {
+	// Point is the first point of the shape hit by the ray.
+	Point Point[T]
+	// Normal is unit surface normal at hit point facing against the ray.
+	Normal Vector[float64]
+	// Time is ray parameter of the hit point (Origin + Direction * Time).
+	Time float64
+	// Distance is distance from ray origin to the hit point.
+	Distance float64
+}
+
+// Translate creates a new Ray translated by the given vector.
+func (r Ray[T]) Translate(vector Vector[T]) Ray[T] {
+	return Ray[T]{r.Origin.Add(vector), r.Direction}
+}
+
+// MoveTo creates a new Ray with the origin moved to point and same direction.
+func (r Ray[T]) MoveTo(point Point[T]) Ray[T] {
+	return Ray[T]{point, r.Direction}
+}
+
+// At returns the point on the ray at the given parameter (Origin + Direction * t).
+func (r Ray[T]) At(t float64) Point[T] {
+	return r.Origin.Add(r.Direction.Multiply(t))
+}
+
+// CastLine casts the ray against the line segment.
+func (r Ray[T]) CastLine(line Line[T]) (RayHit[T], bool) {
+	ray := r.Float()
+
+	t, normal, ok := raySegment(ray, line.Start.Float(), line.End.Float())
+	if !ok {
+		return RayHit[T]{}, false
+	}
+
+	return rayHit[T](ray, t, normal), true
+}
+
+// CastCircle casts the ray against the circle.
+// Ray with origin inside the circle hits it at time zero.
+func (r Ray[T]) CastCircle(circle Circle[T]) (RayHit[T], bool) {
+	ray, c := r.Float(), circle.Float()
+
+	offset := ray.Origin.Subtract(c.Center)
+	if offset.LengthSquared() <= c.Radius*c.Radius {
+		return rayHit[T](ray, 0, ray.Direction.Negate().Normalize()), true
+	}
+
+	t, ok := rayCircle(ray, c)
+	if !ok {
+		return RayHit[T]{}, false
+	}
+
+	return rayHit[T](ray, t, ray.At(t).Subtract(c.Center).Normalize()), true
+}
+
+// CastRectangle casts the ray against the rectangle.
+// Ray with origin inside the rectangle hits it at time zero.
+func (r Ray[T]) CastRectangle(rect Rectangle[T]) (RayHit[T], bool) {
+	ray, rc := r.Float(), rect.Float()
+
+	if rc.Contains(ray.Origin) {
+		return rayHit[T](ray, 0, ray.Direction.Negate().Normalize()), true
+	}
+
+	t, normal, ok := rayRectangle(ray, rc)
+	if !ok {
+		return RayHit[T]{}, false
+	}
+
+	return rayHit[T](ray, t, normal), true
+}
+
+// CastPolygon casts the ray against the polygon.
+// Ray with origin inside the polygon hits it at time zero.
+func (r Ray[T]) CastPolygon(polygon Polygon[T]) (RayHit[T], bool) {
+	ray, vertices := r.Float(), polygon.Float().Vertices
+
+	if polygonContains(vertices, ray.Origin) {
+		return rayHit[T](ray, 0, ray.Direction.Negate().Normalize()), true
+	}
+
+	t, normal, ok := rayPolygon(ray, vertices)
+	if !ok {
+		return RayHit[T]{}, false
+	}
+
+	return rayHit[T](ray, t, normal), true
+}
+
+// CastRegularPolygon casts the ray against the regular polygon.
+// Ray with origin inside the polygon hits it at time zero.
+func (r Ray[T]) CastRegularPolygon(polygon RegularPolygon[T]) (RayHit[T], bool) {
+	return r.CastPolygon(polygon.Polygon())
+}
+
+// Equal checks if the origin and direction of the rays are equal.
+func (r Ray[T]) Equal(ray Ray[T]) bool {
+	return r.Origin.Equal(ray.Origin) && r.Direction.Equal(ray.Direction)
+}
+
+// IsZero checks if origin and direction are zero.
+func (r Ray[T]) IsZero() bool {
+	return r.Origin.IsZero() && r.Direction.IsZero()
+}
+
+// Int converts the ray to a [int] ray.
+func (r Ray[T]) Int() Ray[int] {
+	return Ray[int]{r.Origin.Int(), r.Direction.Int()}
+}
+
+// Float converts the ray to a [float64] ray.
+func (r Ray[T]) Float() Ray[float64] {
+	return Ray[float64]{r.Origin.Float(), r.Direction.Float()}
+}
+
+// String returns a string representation of the Ray.
+func (r Ray[T]) String() string {
+	return fmt.Sprintf("R(%s;%s)", r.Origin.String(), r.Direction.String())
+}
+
+// rayHit creates a hit result at the given ray parameter.
+func rayHit[T Number](ray Ray[float64], t float64, normal Vector[float64]) RayHit[T] {
+	return RayHit[T]{pointCast[T](ray.At(t)), normal, t, t * ray.Direction.Length()}
+}
+
+// raySegment returns ray parameter and normal of the first intersection with the segment.
+func raySegment(ray Ray[float64], start, end Point[float64]) (float64, Vector[float64], bool) {
+	edge := end.Subtract(start)
+	offset := start.Subtract(ray.Origin)
+	denominator := ray.Direction.Cross(edge)
+
+	if equalDelta(denominator, 0, Delta*Delta) {
+		// parallel, hit only if collinear at the nearest endpoint ahead
+		if ray.Direction.IsZero() || !equalDelta(offset.Cross(ray.Direction), 0, Delta*Delta) {
+			return 0, Vector[float64]{}, false
+		}
+
+		lengthSquared := ray.Direction.LengthSquared()
+		t1, t2 := offset.Dot(ray.Direction)/lengthSquared, end.Subtract(ray.Origin).Dot(ray.Direction)/lengthSquared
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if t2 < 0 {
+			return 0, Vector[float64]{}, false
+		}
+
+		return max(t1, 0), ray.Direction.Negate().Normalize(), true
+	}
+
+	t := offset.Cross(edge) / denominator
+	s := offset.Cross(ray.Direction) / denominator
+	if t < 0 || s < 0 || s > 1 {
+		return 0, Vector[float64]{}, false
+	}
+
+	normal := edge.Normal().Normalize()
+	if normal.Dot(ray.Direction) > 0 {
+		normal = normal.Negate()
+	}
+
+	return t, normal, true
+}
+
+// rayCircle returns ray parameter of the first intersection with the circle boundary ahead of the origin.
+func rayCircle(ray Ray[float64], circle Circle[float64]) (float64, bool) {
+	offset := ray.Origin.Subtract(circle.Center)
+
+	a := ray.Direction.LengthSquared()
+	b := offset.Dot(ray.Direction)
+	c := offset.LengthSquared() - circle.Radius*circle.Radius
+
+	discriminant := b*b - a*c
+	if a == 0 || discriminant < 0 {
+		return 0, false
+	}
+
+	t := (-b - math.Sqrt(discriminant)) / a
+	if t < 0 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// rayRectangle returns ray parameter and normal of the entering intersection with the rectangle (slab method).
+func rayRectangle(ray Ray[float64], rect Rectangle[float64]) (float64, Vector[float64], bool) {
+	minPoint, maxPoint := rect.Min(), rect.Max()
+
+	tMin, tMax := math.Inf(-1), math.Inf(1)
+	var normal Vector[float64]
+
+	slabs := []struct {
+		origin, direction, minimum, maximum float64
+		axis                                Vector[float64]
+	}{
+		{ray.Origin.X, ray.Direction.X, minPoint.X, maxPoint.X, Vector[float64]{1, 0}},
+		{ray.Origin.Y, ray.Direction.Y, minPoint.Y, maxPoint.Y, Vector[float64]{0, 1}},
+	}
+
+	for _, slab := range slabs {
+		if slab.direction == 0 {
+			if slab.origin < slab.minimum || slab.origin > slab.maximum {
+				return 0, Vector[float64]{}, false
+			}
+			continue
+		}
+
+		t1, t2 := (slab.minimum-slab.origin)/slab.direction, (slab.maximum-slab.origin)/slab.direction
+		face := slab.axis.Negate()
+		if t1 > t2 {
+			t1, t2, face = t2, t1, slab.axis
+		}
+
+		if t1 > tMin {
+			tMin, normal = t1, face
+		}
+		tMax = min(tMax, t2)
+	}
+
+	if tMin > tMax || tMin < 0 {
+		return 0, Vector[float64]{}, false
+	}
+
+	return tMin, normal, true
+}
+
+// rayPolygon returns ray parameter and normal of the first intersection with any polygon edge.
+func rayPolygon(ray Ray[float64], vertices []Point[float64]) (float64, Vector[float64], bool) {
+	tMin, found := math.Inf(1), false
+	var normal Vector[float64]
+
+	for i, vertex := range vertices {
+		t, n, ok := raySegment(ray, vertex, vertices[(i+1)%len(vertices)])
+		if ok && t < tMin {
+			tMin, normal, found = t, n, true
+		}
+	}
+
+	return tMin, normal, found
+}
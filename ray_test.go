@@ -0,0 +1,203 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+var (
+	rayInt   = Ray[int]{Point[int]{1, 2}, Vector[int]{3, 0}}
+	rayFloat = Ray[float64]{Point[float64]{0.5, -0.25}, Vector[float64]{0.0, 2.0}}
+)
+
+func TestRay_New(t *testing.T) {
+	AssertRay(t, Ry(Pt(1, -1), Vec(2, 0)), 1, -1, 2, 0)
+	AssertRay(t, Ry(Pt(0.5, -1.25), Vec(2.5, 3.75)), 0.5, -1.25, 2.5, 3.75)
+}
+
+func TestRay_Translate(t *testing.T) {
+	AssertRay(t, rayInt.Translate(Vec(3, -2)), 4, 0, 3, 0)
+	AssertRay(t, rayFloat.Translate(Vec(100.1, -0.1)), 100.6, -0.35, 0, 2)
+}
+
+func TestRay_MoveTo(t *testing.T) {
+	AssertRay(t, rayInt.MoveTo(Pt(3, -2)), 3, -2, 3, 0)
+	AssertRay(t, rayFloat.MoveTo(Pt(100.1, -0.1)), 100.1, -0.1, 0, 2)
+}
+
+func TestRay_At(t *testing.T) {
+	AssertPoint(t, rayInt.At(2), 7, 2)
+	AssertPoint(t, rayFloat.At(0.5), 0.5, 0.75)
+}
+
+func TestRay_CastLine(t *testing.T) {
+	ray := Ry(Pt(0.0, 0.0), Vec(2.0, 0.0))
+
+	hit, ok := ray.CastLine(Ln(Pt(4.0, -1.0), Pt(4.0, 1.0)))
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 4, 0)
+	AssertVector(t, hit.Normal, -1, 0)
+	assert.EqualDelta(t, hit.Time, 2.0, Delta)
+	assert.EqualDelta(t, hit.Distance, 4.0, Delta)
+
+	hit, ok = ray.CastLine(Ln(Pt(3.0, 0.0), Pt(6.0, 0.0)))
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 3, 0)
+
+	_, ok = ray.CastLine(Ln(Pt(-4.0, -1.0), Pt(-4.0, 1.0)))
+	assert.False(t, ok)
+	_, ok = ray.CastLine(Ln(Pt(4.0, 1.0), Pt(4.0, 2.0)))
+	assert.False(t, ok)
+	_, ok = ray.CastLine(Ln(Pt(0.0, 1.0), Pt(4.0, 1.0)))
+	assert.False(t, ok)
+}
+
+func TestRay_CastCircle(t *testing.T) {
+	hit, ok := Ry(Pt(0.0, 0.0), Vec(1.0, 0.0)).CastCircle(Circ(Pt(5.0, 0.0), 2.0))
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 3, 0)
+	AssertVector(t, hit.Normal, -1, 0)
+	assert.EqualDelta(t, hit.Time, 3.0, Delta)
+	assert.EqualDelta(t, hit.Distance, 3.0, Delta)
+
+	hit, ok = Ry(Pt(5.0, 0.0), Vec(1.0, 0.0)).CastCircle(Circ(Pt(5.0, 0.0), 2.0))
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 5, 0)
+	assert.EqualDelta(t, hit.Time, 0.0, Delta)
+
+	_, ok = Ry(Pt(0.0, 0.0), Vec(-1.0, 0.0)).CastCircle(Circ(Pt(5.0, 0.0), 2.0))
+	assert.False(t, ok)
+	_, ok = Ry(Pt(0.0, 3.0), Vec(1.0, 0.0)).CastCircle(Circ(Pt(5.0, 0.0), 2.0))
+	assert.False(t, ok)
+}
+
+func TestRay_CastRectangle(t *testing.T) {
+	rectangle := Rect(Pt(5.0, 0.0), Sz(2.0, 4.0))
+
+	hit, ok := Ry(Pt(0.0, 1.0), Vec(2.0, 0.0)).CastRectangle(rectangle)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 4, 1)
+	AssertVector(t, hit.Normal, -1, 0)
+	assert.EqualDelta(t, hit.Time, 2.0, Delta)
+	assert.EqualDelta(t, hit.Distance, 4.0, Delta)
+
+	hit, ok = Ry(Pt(5.0, 10.0), Vec(0.0, -1.0)).CastRectangle(rectangle)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 5, 2)
+	AssertVector(t, hit.Normal, 0, 1)
+
+	hit, ok = Ry(Pt(5.0, 0.0), Vec(0.0, -1.0)).CastRectangle(rectangle)
+	assert.True(t, ok)
+	assert.EqualDelta(t, hit.Time, 0.0, Delta)
+
+	_, ok = Ry(Pt(0.0, 3.0), Vec(1.0, 0.0)).CastRectangle(rectangle)
+	assert.False(t, ok)
+	_, ok = Ry(Pt(0.0, 0.0), Vec(-1.0, 0.0)).CastRectangle(rectangle)
+	assert.False(t, ok)
+
+	hitInt, ok := Ry(Pt(0, 0), Vec(1, 0)).CastRectangle(Rect(Pt(5, 0), Sz(2, 2)))
+	assert.True(t, ok)
+	AssertPoint(t, hitInt.Point, 4, 0)
+}
+
+func TestRay_CastPolygon(t *testing.T) {
+	triangle := Pol([]Point[float64]{{4, -2}, {6, 0}, {4, 2}})
+
+	hit, ok := Ry(Pt(10.0, 0.0), Vec(-1.0, 0.0)).CastPolygon(triangle)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 6, 0)
+	assert.EqualDelta(t, hit.Time, 4.0, Delta)
+
+	hit, ok = Ry(Pt(10.0, 1.0), Vec(-1.0, 0.0)).CastPolygon(triangle)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 5, 1)
+	AssertVector(t, hit.Normal, OneOverSqrt2, OneOverSqrt2)
+
+	hit, ok = Ry(Pt(4.5, 0.0), Vec(1.0, 0.0)).CastPolygon(triangle)
+	assert.True(t, ok)
+	assert.EqualDelta(t, hit.Time, 0.0, Delta)
+
+	_, ok = Ry(Pt(10.0, 3.0), Vec(-1.0, 0.0)).CastPolygon(triangle)
+	assert.False(t, ok)
+
+	// duplicate vertex (zero-length edge) does not contain other points
+	duplicate := Pol([]Point[float64]{{4, -2}, {6, 0}, {6, 0}, {4, 2}})
+	hit, ok = Ry(Pt(10.0, 3.0), Vec(0.0, -1.0)).CastPolygon(duplicate)
+	assert.False(t, ok)
+
+	hit, ok = Ry(Pt(10.0, 0.0), Vec(-1.0, 0.0)).CastPolygon(duplicate)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 6, 0)
+}
+
+func TestRay_CastRegularPolygon(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	hit, ok := Ry(Pt(0.0, 5.0), Vec(0.0, -1.0)).CastRegularPolygon(hexagon)
+	assert.True(t, ok)
+	AssertPoint(t, hit.Point, 0, Sqrt3/2)
+	AssertVector(t, hit.Normal, 0, 1)
+	assert.EqualDelta(t, hit.Distance, 5-Sqrt3/2, Delta)
+
+	_, ok = Ry(Pt(0.0, 5.0), Vec(0.0, 1.0)).CastRegularPolygon(hexagon)
+	assert.False(t, ok)
+}
+
+func TestRay_Equal(t *testing.T) {
+	assert.False(t, rayInt.Equal(Ry(Pt(1, 2), Vec(3, 4))))
+	assert.True(t, rayInt.Equal(rayInt))
+
+	assert.False(t, rayFloat.Equal(Ry(Pt(0.5, -0.25), Vec(math.Pi, 2.0))))
+	assert.True(t, rayFloat.Equal(rayFloat))
+}
+
+func TestRay_IsZero(t *testing.T) {
+	assert.True(t, Ray[int]{}.IsZero())
+	assert.False(t, Ry(Pt(1, 0), Vec(0, 0)).IsZero())
+	assert.False(t, Ry(Pt(0, 0), Vec(0, 1)).IsZero())
+	assert.False(t, rayFloat.IsZero())
+}
+
+func TestRay_Int(t *testing.T) {
+	AssertRay(t, rayInt.Int(), 1, 2, 3, 0)
+	AssertRay(t, rayFloat.Int(), 1, 0, 0, 2)
+}
+
+func TestRay_Float(t *testing.T) {
+	AssertRay(t, rayInt.Float(), 1.0, 2.0, 3.0, 0.0)
+	AssertRay(t, rayFloat.Float(), 0.5, -0.25, 0.0, 2.0)
+}
+
+func TestShape_CastRay(t *testing.T) {
+	ray := Ry(Pt(-10.0, 0.5), Vec(1.0, 0.0))
+	shapes := []Shape[float64]{Circ(Pt(0.0, 0.0), 2.0), Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)), Ln(Pt(0.0, -1.0), Pt(0.0, 1.0)), Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop).Polygon(), Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)}
+
+	for _, shape := range shapes {
+		hit, ok := shape.(interface {
+			CastRay(ray Ray[float64]) (RayHit[float64], bool)
+		}).CastRay(ray)
+		assert.True(t, ok)
+		assert.True(t, shape.Contains(hit.Point))
+		assert.True(t, hit.Time > 7 && hit.Time <= 10)
+	}
+}
+
+func TestRay_String(t *testing.T) {
+	assert.Equal(t, rayInt.String(), "R((1,2);⟨3,0⟩)")
+	assert.Equal(t, rayFloat.String(), "R((0.50,-0.25);⟨0,2⟩)")
+}
+
+func TestRay_Marshal(t *testing.T) {
+	assert.JSON(t, rayInt, `{"o":{"x":1,"y":2},"d":{"x":3,"y":0}}`)
+}
+
+func TestRay_Immutable(t *testing.T) {
+	r := rayInt
+
+	r.Translate(Vec(3, -2))
+	r.MoveTo(Pt(4, 3))
+
+	assert.True(t, r.Equal(rayInt))
+}
@@ -20,6 +20,16 @@ func (r Rectangle[T]) Translate(vector Vector[T]) Rectangle[T] {
 	return Rectangle[T]{r.Center.Add(vector), r.Size}
 }
 
+// TranslateShape creates a new Rectangle translated by the given vector as Shape.
+func (r Rectangle[T]) TranslateShape(vector Vector[T]) Shape[T] {
+	return r.Translate(vector)
+}
+
+// Centroid returns the center of the rectangle.
+func (r Rectangle[T]) Centroid() Point[T] {
+	return r.Center
+}
+
 // MoveTo creates a new Rectangle with the same size centered at point.
 func (r Rectangle[T]) MoveTo(point Point[T]) Rectangle[T] {
 	return Rectangle[T]{point, r.Size}
@@ -163,6 +173,58 @@ func (r Rectangle[T]) Bounds() Rectangle[T] {
 	return r
 }
 
+// Intersect returns the overlapping part of two rectangles.
+// Rectangles which do not overlap or only touch have no intersection.
+func (r Rectangle[T]) Intersect(rect Rectangle[T]) (Rectangle[T], bool) {
+	min1, max1 := r.Min(), r.Max()
+	min2, max2 := rect.Min(), rect.Max()
+
+	minPoint := Point[T]{max(min1.X, min2.X), max(min1.Y, min2.Y)}
+	maxPoint := Point[T]{min(max1.X, max2.X), min(max1.Y, max2.Y)}
+	if minPoint.X >= maxPoint.X || minPoint.Y >= maxPoint.Y {
+		return Rectangle[T]{}, false
+	}
+
+	return RectFromMinMax(minPoint, maxPoint), true
+}
+
+// Union returns the smallest rectangle containing both rectangles.
+func (r Rectangle[T]) Union(rect Rectangle[T]) Rectangle[T] {
+	min1, max1 := r.Min(), r.Max()
+	min2, max2 := rect.Min(), rect.Max()
+
+	return RectFromMinMax(Point[T]{min(min1.X, min2.X), min(min1.Y, min2.Y)}, Point[T]{max(max1.X, max2.X), max(max1.Y, max2.Y)})
+}
+
+// Difference returns up to four non-overlapping rectangles covering the part of rectangle outside the given rectangle.
+func (r Rectangle[T]) Difference(rect Rectangle[T]) []Rectangle[T] {
+	intersection, ok := r.Intersect(rect)
+	if !ok {
+		return []Rectangle[T]{r}
+	}
+
+	minPoint, maxPoint := r.Min(), r.Max()
+	innerMin, innerMax := intersection.Min(), intersection.Max()
+
+	rects := make([]Rectangle[T], 0, 4)
+	// top and bottom bands in full width
+	if minPoint.Y < innerMin.Y {
+		rects = append(rects, RectFromMinMax(minPoint, Point[T]{maxPoint.X, innerMin.Y}))
+	}
+	if innerMax.Y < maxPoint.Y {
+		rects = append(rects, RectFromMinMax(Point[T]{minPoint.X, innerMax.Y}, maxPoint))
+	}
+	// left and right parts of the middle band
+	if minPoint.X < innerMin.X {
+		rects = append(rects, RectFromMinMax(Point[T]{minPoint.X, innerMin.Y}, Point[T]{innerMin.X, innerMax.Y}))
+	}
+	if innerMax.X < maxPoint.X {
+		rects = append(rects, RectFromMinMax(Point[T]{innerMax.X, innerMin.Y}, Point[T]{maxPoint.X, innerMax.Y}))
+	}
+
+	return rects
+}
+
 // Clamp creates a new point clamped to the rectangle.
 func (r Rectangle[T]) Clamp(point Point[T]) Point[T] {
 	minPoint, maxPoint := r.Min(), r.Max()
@@ -170,6 +232,55 @@ func (r Rectangle[T]) Clamp(point Point[T]) Point[T] {
 	return Point[T]{Clamp(point.X, minPoint.X, maxPoint.X), Clamp(point.Y, minPoint.Y, maxPoint.Y)}
 }
 
+// ClosestPoint returns the point on the rectangle boundary nearest to the given point.
+// Unlike Clamp, points inside the rectangle are moved to the nearest side.
+func (r Rectangle[T]) ClosestPoint(point Point[T]) Point[T] {
+	if !r.Contains(point) {
+		return r.Clamp(point)
+	}
+
+	minPoint, maxPoint := r.Min(), r.Max()
+	closest := Point[T]{minPoint.X, point.Y}
+	distance := point.X - minPoint.X
+
+	if d := maxPoint.X - point.X; d < distance {
+		closest, distance = Point[T]{maxPoint.X, point.Y}, d
+	}
+	if d := point.Y - minPoint.Y; d < distance {
+		closest, distance = Point[T]{point.X, minPoint.Y}, d
+	}
+	if d := maxPoint.Y - point.Y; d < distance {
+		closest = Point[T]{point.X, maxPoint.Y}
+	}
+
+	return closest
+}
+
+// SignedDistance returns distance from the rectangle boundary to the given point, negative inside the rectangle.
+func (r Rectangle[T]) SignedDistance(point Point[T]) float64 {
+	offset := point.Float().Subtract(r.Center.Float()).Abs().Subtract(r.Size.Float().Scale(0.5).Vector())
+
+	outside := Vector[float64]{max(offset.X, 0), max(offset.Y, 0)}.Length()
+	inside := min(max(offset.X, offset.Y), 0)
+
+	return outside + inside
+}
+
+// Support returns the farthest vertex of the rectangle in the given direction.
+func (r Rectangle[T]) Support(direction Vector[float64]) Point[float64] {
+	minPoint, maxPoint := r.Min().Float(), r.Max().Float()
+
+	point := minPoint
+	if direction.X > 0 {
+		point.X = maxPoint.X
+	}
+	if direction.Y > 0 {
+		point.Y = maxPoint.Y
+	}
+
+	return point
+}
+
 // Equal checks for equal center and size values using tolerant numeric comparison.
 func (r Rectangle[T]) Equal(rectangle Rectangle[T]) bool {
 	return r.Center.Equal(rectangle.Center) && r.Size.Equal(rectangle.Size)
@@ -180,6 +291,11 @@ func (r Rectangle[T]) IsZero() bool {
 	return r.Center.IsZero() && r.Size.IsZero()
 }
 
+// CastRay casts the given ray against the rectangle (same as Ray.CastRectangle).
+func (r Rectangle[T]) CastRay(ray Ray[T]) (RayHit[T], bool) {
+	return ray.CastRectangle(r)
+}
+
 // Contains reports whether the given point lies within or on the rectangle bounds.
 func (r Rectangle[T]) Contains(point Point[T]) bool {
 	minPoint, maxPoint := r.Min(), r.Max()
@@ -219,6 +335,20 @@ func RectFromMinMax[T Number](min, max Point[T]) Rectangle[T] {
 	return RectFromMin(min, Sz(max.Subtract(min).XY()))
 }
 
+// RectUnion creates the smallest Rectangle containing all given rectangles.
+func RectUnion[T Number](rects ...Rectangle[T]) Rectangle[T] {
+	if len(rects) == 0 {
+		return Rectangle[T]{}
+	}
+
+	union := rects[0]
+	for _, rect := range rects[1:] {
+		union = union.Union(rect)
+	}
+
+	return union
+}
+
 // RectFromSize creates a Rectangle from zero point and size.
 func RectFromSize[T Number](size Size[T]) Rectangle[T] {
 	return RectFromMin(Pt[T](0, 0), size)
@@ -32,6 +32,12 @@ func TestRectangle_New(t *testing.T) {
 	AssertRect(t, RectFromMin(Pt(0, 0), Sz(1, 1)), 0, 0, 1, 1)
 }
 
+func TestRectUnion(t *testing.T) {
+	AssertRect(t, RectUnion(RectFromMinMax(Pt(0, 0), Pt(1, 1)), RectFromMinMax(Pt(3, -2), Pt(4, 0)), RectFromMinMax(Pt(1, 1), Pt(2, 4))), 2, 1, 4, 6)
+	AssertRect(t, RectUnion(rectInt), rectInt.Center.X, rectInt.Center.Y, rectInt.Size.Width, rectInt.Size.Height)
+	AssertRect(t, RectUnion[float64](), 0, 0, 0, 0)
+}
+
 func TestRectangle_Translate(t *testing.T) {
 	AssertRect(t, rectInt.Translate(Vec(3, -2)), 4, 0, 2, 3)
 	AssertRect(t, rectFloat.Translate(Vec(100.1, -0.1)), 100.7, -0.35, 1.2, 3.6)
@@ -150,12 +156,85 @@ func TestRectangle_Bounds(t *testing.T) {
 	AssertRect(t, rectFloat.Bounds(), 0.6, -0.25, 1.2, 3.6)
 }
 
+func TestRectangle_Intersect(t *testing.T) {
+	rectangle := RectFromMinMax(Pt(0, 0), Pt(4, 4))
+
+	intersection, ok := rectangle.Intersect(RectFromMinMax(Pt(2, 1), Pt(6, 3)))
+	assert.True(t, ok)
+	assert.Equal(t, intersection, RectFromMinMax(Pt(2, 1), Pt(4, 3)))
+
+	intersection, ok = rectangle.Intersect(RectFromMinMax(Pt(1, 1), Pt(2, 2)))
+	assert.True(t, ok)
+	assert.Equal(t, intersection, RectFromMinMax(Pt(1, 1), Pt(2, 2)))
+
+	_, ok = rectangle.Intersect(RectFromMinMax(Pt(4, 0), Pt(6, 4)))
+	assert.False(t, ok)
+	_, ok = rectangle.Intersect(RectFromMinMax(Pt(5, 5), Pt(6, 6)))
+	assert.False(t, ok)
+
+	intersectionFloat, ok := Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)).Intersect(Rect(Pt(1.0, 0.5), Sz(2.0, 2.0)))
+	assert.True(t, ok)
+	AssertRect(t, intersectionFloat, 0.5, 0.25, 1, 1.5)
+}
+
+func TestRectangle_Union(t *testing.T) {
+	AssertRect(t, RectFromMinMax(Pt(0, 0), Pt(4, 4)).Union(RectFromMinMax(Pt(2, 1), Pt(6, 3))), 3, 2, 6, 4)
+	AssertRect(t, Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)).Union(Rect(Pt(5.0, 5.0), Sz(1.0, 1.0))), 2.25, 2.25, 6.5, 6.5)
+}
+
+func TestRectangle_Difference(t *testing.T) {
+	rectangle := RectFromMinMax(Pt(0, 0), Pt(6, 6))
+
+	assert.Equal(t, rectangle.Difference(RectFromMinMax(Pt(2, 2), Pt(4, 4))), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(6, 2)),
+		RectFromMinMax(Pt(0, 4), Pt(6, 6)),
+		RectFromMinMax(Pt(0, 2), Pt(2, 4)),
+		RectFromMinMax(Pt(4, 2), Pt(6, 4)),
+	})
+	assert.Equal(t, rectangle.Difference(RectFromMinMax(Pt(3, -1), Pt(7, 7))), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(3, 6)),
+	})
+	assert.Equal(t, rectangle.Difference(RectFromMinMax(Pt(-1, 4), Pt(7, 7))), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(6, 4)),
+	})
+	assert.Equal(t, rectangle.Difference(RectFromMinMax(Pt(7, 7), Pt(8, 8))), []Rectangle[int]{rectangle})
+	assert.Equal(t, len(rectangle.Difference(RectFromMinMax(Pt(-1, -1), Pt(7, 7)))), 0)
+}
+
 func TestRectangle_Clamp(t *testing.T) {
 	AssertPoint(t, rectInt.Clamp(Pt(2, 2)), 2, 2)
 	AssertPoint(t, rectInt.Clamp(Pt(10, 10)), 2, 4)
 	AssertPoint(t, rectFloat.Clamp(Pt(-1.0, 1.2)), 0.0, 1.2)
 }
 
+func TestRectangle_ClosestPoint(t *testing.T) {
+	rectangle := Rect(Pt(0.0, 0.0), Sz(4.0, 2.0))
+
+	AssertPoint(t, rectangle.ClosestPoint(Pt(5.0, 5.0)), 2, 1)
+	AssertPoint(t, rectangle.ClosestPoint(Pt(-5.0, 0.5)), -2, 0.5)
+	AssertPoint(t, rectangle.ClosestPoint(Pt(1.5, 0.0)), 2, 0)
+	AssertPoint(t, rectangle.ClosestPoint(Pt(-0.5, -0.75)), -0.5, -1)
+	AssertPoint(t, rectangle.ClosestPoint(Pt(0.5, 0.25)), 0.5, 1)
+}
+
+func TestRectangle_SignedDistance(t *testing.T) {
+	rectangle := Rect(Pt(0.0, 0.0), Sz(4.0, 2.0))
+
+	assert.EqualDelta(t, rectangle.SignedDistance(Pt(5.0, 5.0)), 5.0, Delta)
+	assert.EqualDelta(t, rectangle.SignedDistance(Pt(-5.0, 0.5)), 3.0, Delta)
+	assert.EqualDelta(t, rectangle.SignedDistance(Pt(2.0, 0.5)), 0.0, Delta)
+	assert.EqualDelta(t, rectangle.SignedDistance(Pt(1.5, 0.0)), -0.5, Delta)
+	assert.EqualDelta(t, rectangle.SignedDistance(Pt(0.0, 0.0)), -1.0, Delta)
+}
+
+func TestRectangle_Support(t *testing.T) {
+	rectangle := Rect(Pt(0.0, 0.0), Sz(4.0, 2.0))
+
+	AssertPoint(t, rectangle.Support(Vec(1.0, 1.0)), 2, 1)
+	AssertPoint(t, rectangle.Support(Vec(-1.0, 0.5)), -2, 1)
+	AssertPoint(t, rectangle.Support(Vec(0.5, -3.0)), 2, -1)
+}
+
 func TestRectangle_Equal(t *testing.T) {
 	assert.False(t, rectInt.Equal(Rect(Pt(3, -3), Sz(3, 4))))
 	assert.True(t, rectInt.Equal(rectInt))
@@ -0,0 +1,255 @@
+package geom
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Region is an arbitrary set of points represented by non-overlapping rectangles in banded canonical form (like X11/pixman regions).
+// Rectangles are half-open, they contain their min edges, but not their max edges.
+type Region[T Number] struct {
+	bands []band[T]
+}
+
+// band is a horizontal strip of the region with sorted, non-overlapping and non-touching spans.
+type band[T Number] struct {
+	top, bottom T
+	spans       []span[T]
+}
+
+// span is a horizontal interval of the band.
+type span[T Number] struct {
+	left, right T
+}
+
+// RegionFromRects creates a Region as union of the given rectangles.
+func RegionFromRects[T Number](rects ...Rectangle[T]) Region[T] {
+	var region Region[T]
+	for _, rect := range rects {
+		minPoint, maxPoint := rect.Min(), rect.Max()
+		if minPoint.X >= maxPoint.X || minPoint.Y >= maxPoint.Y {
+			continue
+		}
+
+		region = region.Union(Region[T]{[]band[T]{{minPoint.Y, maxPoint.Y, []span[T]{{minPoint.X, maxPoint.X}}}}})
+	}
+
+	return region
+}
+
+// Union creates a new Region containing points in any of the regions.
+func (r Region[T]) Union(region Region[T]) Region[T] {
+	return combineRegions(r, region, func(in1, in2 bool) bool { return in1 || in2 })
+}
+
+// Intersect creates a new Region containing points in both regions.
+func (r Region[T]) Intersect(region Region[T]) Region[T] {
+	return combineRegions(r, region, func(in1, in2 bool) bool { return in1 && in2 })
+}
+
+// Subtract creates a new Region containing points of the current region not in the given region.
+func (r Region[T]) Subtract(region Region[T]) Region[T] {
+	return combineRegions(r, region, func(in1, in2 bool) bool { return in1 && !in2 })
+}
+
+// Translate creates a new Region translated by the given vector.
+func (r Region[T]) Translate(vector Vector[T]) Region[T] {
+	bands := make([]band[T], len(r.bands))
+	for i, b := range r.bands {
+		spans := make([]span[T], len(b.spans))
+		for j, s := range b.spans {
+			spans[j] = span[T]{s.left + vector.X, s.right + vector.X}
+		}
+		bands[i] = band[T]{b.top + vector.Y, b.bottom + vector.Y, spans}
+	}
+
+	return Region[T]{bands}
+}
+
+// Contains checks if the given point lies inside the region.
+func (r Region[T]) Contains(point Point[T]) bool {
+	i, found := slices.BinarySearchFunc(r.bands, point.Y, func(b band[T], y T) int {
+		switch {
+		case b.bottom <= y:
+			return -1
+		case b.top > y:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if !found {
+		return false
+	}
+
+	_, found = slices.BinarySearchFunc(r.bands[i].spans, point.X, func(s span[T], x T) int {
+		switch {
+		case s.right <= x:
+			return -1
+		case s.left > x:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return found
+}
+
+// Bounds returns the axis-aligned bounding rectangle.
+func (r Region[T]) Bounds() Rectangle[T] {
+	if len(r.bands) == 0 {
+		return Rectangle[T]{}
+	}
+
+	left, right := r.bands[0].spans[0].left, r.bands[0].spans[0].right
+	for _, b := range r.bands {
+		left, right = min(left, b.spans[0].left), max(right, b.spans[len(b.spans)-1].right)
+	}
+
+	return RectFromMinMax(Point[T]{left, r.bands[0].top}, Point[T]{right, r.bands[len(r.bands)-1].bottom})
+}
+
+// Area returns the region area.
+func (r Region[T]) Area() T {
+	var area T
+	for _, b := range r.bands {
+		for _, s := range b.spans {
+			area += (s.right - s.left) * (b.bottom - b.top)
+		}
+	}
+
+	return area
+}
+
+// All returns an iterator over region rectangles in banded (top to bottom, left to right) order.
+func (r Region[T]) All() iter.Seq[Rectangle[T]] {
+	return func(yield func(Rectangle[T]) bool) {
+		for _, b := range r.bands {
+			for _, s := range b.spans {
+				if !yield(RectFromMinMax(Point[T]{s.left, b.top}, Point[T]{s.right, b.bottom})) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Rects returns region rectangles in banded (top to bottom, left to right) order.
+func (r Region[T]) Rects() []Rectangle[T] {
+	return slices.Collect(r.All())
+}
+
+// Equal checks if two regions contain the same points.
+func (r Region[T]) Equal(region Region[T]) bool {
+	return slices.EqualFunc(r.bands, region.bands, band[T].equal)
+}
+
+// Empty checks if the region contains no points.
+func (r Region[T]) Empty() bool {
+	return len(r.bands) == 0
+}
+
+// String returns a string representation of the Region.
+func (r Region[T]) String() string {
+	rects := make([]string, 0, len(r.bands))
+	for rect := range r.All() {
+		rects = append(rects, rect.String())
+	}
+
+	return fmt.Sprintf("Rgn(%s)", strings.Join(rects, ", "))
+}
+
+// equal checks if two bands have the same interval and spans.
+func (b band[T]) equal(other band[T]) bool {
+	return b.top == other.top && b.bottom == other.bottom && slices.Equal(b.spans, other.spans)
+}
+
+// combineRegions creates a new region from points for which operation on membership in both regions is true.
+func combineRegions[T Number](region1, region2 Region[T], operation func(in1, in2 bool) bool) Region[T] {
+	edges := make([]T, 0, 2*(len(region1.bands)+len(region2.bands)))
+	for _, b := range slices.Concat(region1.bands, region2.bands) {
+		edges = append(edges, b.top, b.bottom)
+	}
+	slices.Sort(edges)
+	edges = slices.Compact(edges)
+
+	var bands []band[T]
+	i1, i2 := 0, 0
+	for k := 0; k+1 < len(edges); k++ {
+		top, bottom := edges[k], edges[k+1]
+
+		// advance to bands overlapping current strip
+		for i1 < len(region1.bands) && region1.bands[i1].bottom <= top {
+			i1++
+		}
+		for i2 < len(region2.bands) && region2.bands[i2].bottom <= top {
+			i2++
+		}
+
+		spans := combineSpans(bandSpans(region1.bands, i1, top), bandSpans(region2.bands, i2, top), operation)
+		if len(spans) == 0 {
+			continue
+		}
+
+		// coalesce with vertically adjacent band with the same spans
+		if last := len(bands) - 1; last >= 0 && bands[last].bottom == top && slices.Equal(bands[last].spans, spans) {
+			bands[last].bottom = bottom
+			continue
+		}
+
+		bands = append(bands, band[T]{top, bottom, spans})
+	}
+
+	return Region[T]{bands}
+}
+
+// bandSpans returns spans of band at index if it covers the given y, otherwise none.
+func bandSpans[T Number](bands []band[T], index int, y T) []span[T] {
+	if index < len(bands) && bands[index].top <= y {
+		return bands[index].spans
+	}
+
+	return nil
+}
+
+// combineSpans creates new spans from intervals for which operation on membership in both spans is true.
+func combineSpans[T Number](spans1, spans2 []span[T], operation func(in1, in2 bool) bool) []span[T] {
+	edges := make([]T, 0, 2*(len(spans1)+len(spans2)))
+	for _, s := range slices.Concat(spans1, spans2) {
+		edges = append(edges, s.left, s.right)
+	}
+	slices.Sort(edges)
+	edges = slices.Compact(edges)
+
+	var spans []span[T]
+	i1, i2 := 0, 0
+	for k := 0; k+1 < len(edges); k++ {
+		left, right := edges[k], edges[k+1]
+
+		for i1 < len(spans1) && spans1[i1].right <= left {
+			i1++
+		}
+		for i2 < len(spans2) && spans2[i2].right <= left {
+			i2++
+		}
+
+		in1 := i1 < len(spans1) && spans1[i1].left <= left
+		in2 := i2 < len(spans2) && spans2[i2].left <= left
+		if !operation(in1, in2) {
+			continue
+		}
+
+		// merge with touching span
+		if last := len(spans) - 1; last >= 0 && spans[last].right == left {
+			spans[last].right = right
+			continue
+		}
+
+		spans = append(spans, span[T]{left, right})
+	}
+
+	return spans
+}
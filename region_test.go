@@ -0,0 +1,109 @@
+package geom
+
+import (
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestRegionFromRects(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(4, 2)), RectFromMinMax(Pt(2, 1), Pt(6, 3)))
+
+	assert.Equal(t, region.Rects(), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(4, 1)),
+		RectFromMinMax(Pt(0, 1), Pt(6, 2)),
+		RectFromMinMax(Pt(2, 2), Pt(6, 3)),
+	})
+
+	// touching rectangles are merged into single span and coalesced bands
+	region = RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(2, 2)), RectFromMinMax(Pt(2, 0), Pt(4, 2)), RectFromMinMax(Pt(0, 2), Pt(4, 3)))
+	assert.Equal(t, region.Rects(), []Rectangle[int]{RectFromMinMax(Pt(0, 0), Pt(4, 3))})
+
+	// empty rectangles are ignored
+	assert.True(t, RegionFromRects(Rect(Pt(1, 1), Sz(0, 2))).Empty())
+	assert.True(t, RegionFromRects[float64]().Empty())
+}
+
+func TestRegion_Union(t *testing.T) {
+	region1 := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(2, 2)))
+	region2 := RegionFromRects(RectFromMinMax(Pt(4, 0), Pt(6, 2)))
+
+	assert.Equal(t, region1.Union(region2).Rects(), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(2, 2)),
+		RectFromMinMax(Pt(4, 0), Pt(6, 2)),
+	})
+	assert.True(t, region1.Union(region2).Equal(region2.Union(region1)))
+	assert.True(t, region1.Union(Region[int]{}).Equal(region1))
+}
+
+func TestRegion_Intersect(t *testing.T) {
+	region1 := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(4, 4)))
+	region2 := RegionFromRects(RectFromMinMax(Pt(2, 2), Pt(6, 6)), RectFromMinMax(Pt(-2, 0), Pt(1, 1)))
+
+	assert.Equal(t, region1.Intersect(region2).Rects(), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(1, 1)),
+		RectFromMinMax(Pt(2, 2), Pt(4, 4)),
+	})
+	assert.True(t, region1.Intersect(RegionFromRects(RectFromMinMax(Pt(4, 0), Pt(6, 4)))).Empty())
+}
+
+func TestRegion_Subtract(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(6, 6)))
+	hole := RegionFromRects(RectFromMinMax(Pt(2, 2), Pt(4, 4)))
+
+	assert.Equal(t, region.Subtract(hole).Rects(), []Rectangle[int]{
+		RectFromMinMax(Pt(0, 0), Pt(6, 2)),
+		RectFromMinMax(Pt(0, 2), Pt(2, 4)),
+		RectFromMinMax(Pt(4, 2), Pt(6, 4)),
+		RectFromMinMax(Pt(0, 4), Pt(6, 6)),
+	})
+	assert.Equal(t, region.Subtract(hole).Area(), 32)
+	assert.True(t, region.Subtract(hole).Union(hole).Equal(region))
+	assert.True(t, hole.Subtract(region).Empty())
+}
+
+func TestRegion_Translate(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0.0, 0.0), Pt(1.0, 2.0))).Translate(Vec(1.5, -1.0))
+
+	assert.Equal(t, region.Rects(), []Rectangle[float64]{RectFromMinMax(Pt(1.5, -1.0), Pt(2.5, 1.0))})
+}
+
+func TestRegion_Contains(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(6, 6))).Subtract(RegionFromRects(RectFromMinMax(Pt(2, 2), Pt(4, 4))))
+
+	assert.True(t, region.Contains(Pt(0, 0)))
+	assert.True(t, region.Contains(Pt(1, 3)))
+	assert.True(t, region.Contains(Pt(4, 3)))
+	assert.False(t, region.Contains(Pt(2, 2)))
+	assert.False(t, region.Contains(Pt(3, 3)))
+	assert.False(t, region.Contains(Pt(6, 3)))
+	assert.False(t, region.Contains(Pt(3, 6)))
+	assert.False(t, region.Contains(Pt(-1, 3)))
+	assert.False(t, Region[int]{}.Contains(Pt(0, 0)))
+}
+
+func TestRegion_Bounds(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(2, 2)), RectFromMinMax(Pt(4, 3), Pt(8, 4)))
+
+	AssertRect(t, region.Bounds(), 4, 2, 8, 4)
+	AssertRect(t, Region[float64]{}.Bounds(), 0, 0, 0, 0)
+}
+
+func TestRegion_All(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(2, 2)), RectFromMinMax(Pt(4, 0), Pt(6, 2)))
+
+	count := 0
+	for rect := range region.All() {
+		AssertSize(t, rect.Size, 2, 2)
+		count++
+		break
+	}
+	assert.Equal(t, count, 1)
+}
+
+func TestRegion_String(t *testing.T) {
+	region := RegionFromRects(RectFromMinMax(Pt(0, 0), Pt(2, 2)), RectFromMinMax(Pt(4, 0), Pt(6, 2)))
+
+	assert.Equal(t, region.String(), "Rgn("+RectFromMinMax(Pt(0, 0), Pt(2, 2)).String()+", "+RectFromMinMax(Pt(4, 0), Pt(6, 2)).String()+")")
+	assert.Equal(t, Region[int]{}.String(), "Rgn()")
+}
@@ -23,6 +23,16 @@ func (rp RegularPolygon[T]) Translate(change Vector[T]) RegularPolygon[T] {
 	return RegularPolygon[T]{rp.Center.Add(change), rp.Size, rp.N, rp.Angle}
 }
 
+// TranslateShape creates a new RegularPolygon translated by the given vector as Shape.
+func (rp RegularPolygon[T]) TranslateShape(vector Vector[T]) Shape[T] {
+	return rp.Translate(vector)
+}
+
+// Centroid returns the center of the regular polygon.
+func (rp RegularPolygon[T]) Centroid() Point[T] {
+	return rp.Center
+}
+
 // MoveTo creates a new RegularPolygon with center at point.
 func (rp RegularPolygon[T]) MoveTo(point Point[T]) RegularPolygon[T] {
 	return RegularPolygon[T]{point, rp.Size, rp.N, rp.Angle}
@@ -65,6 +75,29 @@ func (rp RegularPolygon[T]) Bounds() Rectangle[T] {
 	return Rectangle[T]{rp.Center, rp.Size.ScaleXY(2.0*maxAbsCos, 2.0*maxAbsSin)}
 }
 
+// ClosestPoint returns the point on the regular polygon boundary nearest to the given point.
+func (rp RegularPolygon[T]) ClosestPoint(point Point[T]) Point[T] {
+	if rp.N == 0 {
+		return point
+	}
+
+	return pointCast[T](closestBoundary(rp.Float().Vertices(), point.Float()))
+}
+
+// SignedDistance returns distance from the regular polygon boundary to the given point, negative inside the polygon.
+func (rp RegularPolygon[T]) SignedDistance(point Point[T]) float64 {
+	if rp.N == 0 {
+		return math.Inf(1)
+	}
+
+	return signedDistance(rp.Float().Vertices(), point.Float())
+}
+
+// Support returns the farthest vertex of the regular polygon in the given direction.
+func (rp RegularPolygon[T]) Support(direction Vector[float64]) Point[float64] {
+	return supportVertices(rp.Float().Vertices(), direction)
+}
+
 // Polygon converts the regular polygon into a generic Polygon with computed vertices.
 func (rp RegularPolygon[T]) Polygon() Polygon[T] {
 	return Polygon[T]{rp.Vertices()}
@@ -85,6 +118,16 @@ func (rp RegularPolygon[T]) Empty() bool {
 	return rp.N == 0
 }
 
+// CastRay casts the given ray against the regular polygon (same as Ray.CastRegularPolygon).
+func (rp RegularPolygon[T]) CastRay(ray Ray[T]) (RayHit[T], bool) {
+	return ray.CastRegularPolygon(rp)
+}
+
+// Contains checks if the given point lies inside the regular polygon or on its boundary.
+func (rp RegularPolygon[T]) Contains(point Point[T]) bool {
+	return convexContains(rp.Float().Vertices(), point.Float())
+}
+
 // Int converts the regular polygon to a [int] regular polygon.
 func (rp RegularPolygon[T]) Int() RegularPolygon[int] {
 	return RegularPolygon[int]{rp.Center.Int(), rp.Size.Int(), rp.N, rp.Angle}
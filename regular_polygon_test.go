@@ -84,6 +84,27 @@ func TestRegularPolygon_Bounds(t *testing.T) {
 	AssertRect(t, regPolygonInt.Bounds(), 1, 2, 4, 4)
 }
 
+func TestRegularPolygon_ClosestPoint(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	AssertPoint(t, hexagon.ClosestPoint(Pt(0.0, 5.0)), 0, Sqrt3/2)
+	AssertPoint(t, hexagon.ClosestPoint(Pt(0.0, 0.5)), 0, Sqrt3/2)
+	AssertPoint(t, hexagon.ClosestPoint(Pt(3.0, 0.0)), 1, 0)
+}
+
+func TestRegularPolygon_SignedDistance(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	assert.EqualDelta(t, hexagon.SignedDistance(Pt(0.0, 5.0)), 5-Sqrt3/2, Delta)
+	assert.EqualDelta(t, hexagon.SignedDistance(Pt(0.0, 0.0)), -Sqrt3/2, Delta)
+	assert.EqualDelta(t, hexagon.SignedDistance(Pt(3.0, 0.0)), 2.0, Delta)
+}
+
+func TestRegularPolygon_Support(t *testing.T) {
+	AssertPoint(t, RegPol(Pt(0, 0), Sz(2, 3), 4, 0).Support(Vec(0.0, 1.0)), 0, 3)
+	AssertPoint(t, Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop).Support(Vec(1.0, 0.1)), 1, 0)
+}
+
 func TestRegularPolygon_Polygon(t *testing.T) {
 	rp := RegPol(Pt(0.0, 0.0), Sz(1.0, 1.0), 5, 0)
 	p := rp.Polygon()
@@ -109,6 +130,17 @@ func TestRegularPolygon_Empty(t *testing.T) {
 	assert.False(t, polygonFloat.Empty())
 }
 
+func TestRegularPolygon_Contains(t *testing.T) {
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+
+	assert.True(t, hexagon.Contains(Pt(0.0, 0.0)))
+	assert.True(t, hexagon.Contains(Pt(0.9, 0.0)))
+	assert.True(t, hexagon.Contains(Pt(0.0, Sqrt3/2)))
+	assert.False(t, hexagon.Contains(Pt(0.9, 0.5)))
+	assert.True(t, regPolygonInt.Contains(Pt(1, 2)))
+	assert.False(t, regPolygonInt.Contains(Pt(3, 3)))
+}
+
 func TestRegularPolygon_Int(t *testing.T) {
 	AssertRegularPolygon(t, regPolygonInt.Int(), 1, 2, 2, 2, 4, 0.0)
 }
@@ -0,0 +1,274 @@
+// Package rtree provides a static R-tree bulk-loaded with Sort-Tile-Recursive packing, optimized for read-only queries.
+package rtree
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+	"math"
+	"slices"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Tree is a static R-tree of bounded items.
+// Items implementing Contains(Point[T]) bool, SignedDistance(Point[T]) float64 or CastRay(Ray[T]) (RayHit[T], bool)
+// (like all shapes) are tested exactly in point, nearest and ray queries, otherwise their bounds are used.
+type Tree[T geom.Number, V geom.Bounded[T]] struct {
+	root *node[T, V]
+	size int
+}
+
+// node is a tree node, leaves hold entries and branches hold children.
+type node[T geom.Number, V geom.Bounded[T]] struct {
+	bounds   geom.Rectangle[T]
+	children []*node[T, V]
+	entries  []entry[T, V]
+}
+
+// entry is an item with its bounds.
+type entry[T geom.Number, V geom.Bounded[T]] struct {
+	item   V
+	bounds geom.Rectangle[T]
+}
+
+// New creates a Tree from the given items with at most capacity entries per node.
+// Capacity less than 2 is replaced by 2.
+func New[T geom.Number, V geom.Bounded[T]](items []V, capacity int) *Tree[T, V] {
+	capacity = max(capacity, 2)
+
+	if len(items) == 0 {
+		return &Tree[T, V]{}
+	}
+
+	entries := make([]entry[T, V], len(items))
+	for i, item := range items {
+		entries[i] = entry[T, V]{item, item.Bounds()}
+	}
+
+	var nodes []*node[T, V]
+	for _, tile := range tiles(entries, func(e entry[T, V]) geom.Rectangle[T] { return e.bounds }, capacity) {
+		n := &node[T, V]{bounds: tile[0].bounds, entries: tile}
+		for _, e := range tile[1:] {
+			n.bounds = n.bounds.Union(e.bounds)
+		}
+		nodes = append(nodes, n)
+	}
+
+	for len(nodes) > 1 {
+		var parents []*node[T, V]
+		for _, tile := range tiles(nodes, func(n *node[T, V]) geom.Rectangle[T] { return n.bounds }, capacity) {
+			n := &node[T, V]{bounds: tile[0].bounds, children: tile}
+			for _, child := range tile[1:] {
+				n.bounds = n.bounds.Union(child.bounds)
+			}
+			parents = append(parents, n)
+		}
+		nodes = parents
+	}
+
+	return &Tree[T, V]{nodes[0], len(items)}
+}
+
+// Len returns number of items.
+func (t *Tree[T, V]) Len() int {
+	return t.size
+}
+
+// Bounds returns bounds of all items.
+func (t *Tree[T, V]) Bounds() geom.Rectangle[T] {
+	if t.root == nil {
+		return geom.Rectangle[T]{}
+	}
+
+	return t.root.bounds
+}
+
+// QueryRect returns an iterator over items whose bounds collide with the given rectangle.
+func (t *Tree[T, V]) QueryRect(rect geom.Rectangle[T]) iter.Seq[V] {
+	return t.query(func(bounds geom.Rectangle[T]) bool {
+		return geom.CollisionRectangles(bounds, rect)
+	}, func(V) bool {
+		return true
+	})
+}
+
+// QueryPoint returns an iterator over items containing the given point.
+func (t *Tree[T, V]) QueryPoint(point geom.Point[T]) iter.Seq[V] {
+	return t.query(func(bounds geom.Rectangle[T]) bool {
+		return bounds.Contains(point)
+	}, func(item V) bool {
+		if shape, ok := any(item).(interface{ Contains(geom.Point[T]) bool }); ok {
+			return shape.Contains(point)
+		}
+
+		return true
+	})
+}
+
+// QueryRay returns an iterator over items hit by the ray within maximum time with their hits, ordered by hit time.
+// Items not implementing CastRay are reported with the hit of their bounds.
+func (t *Tree[T, V]) QueryRay(ray geom.Ray[T], maxTime float64) iter.Seq2[V, geom.RayHit[T]] {
+	return func(yield func(V, geom.RayHit[T]) bool) {
+		if t.root == nil {
+			return
+		}
+
+		var q queue[T, V]
+		push := func(bounds geom.Rectangle[T], n *node[T, V], e entry[T, V]) {
+			if hit, ok := ray.CastRectangle(bounds); ok && hit.Time <= maxTime {
+				heap.Push(&q, queued[T, V]{hit.Time, n, e, hit, false})
+			}
+		}
+
+		push(t.root.bounds, t.root, entry[T, V]{})
+		for q.Len() > 0 {
+			next := heap.Pop(&q).(queued[T, V])
+			if next.node == nil {
+				shape, ok := any(next.entry.item).(interface {
+					CastRay(geom.Ray[T]) (geom.RayHit[T], bool)
+				})
+				if next.exact || !ok {
+					if !yield(next.entry.item, next.hit) {
+						return
+					}
+					continue
+				}
+
+				// shape is hit no sooner than its bounds, so it is queued again with the exact hit
+				if hit, ok := shape.CastRay(ray); ok && hit.Time <= maxTime {
+					heap.Push(&q, queued[T, V]{hit.Time, nil, next.entry, hit, true})
+				}
+				continue
+			}
+
+			for _, child := range next.node.children {
+				push(child.bounds, child, entry[T, V]{})
+			}
+			for _, e := range next.node.entries {
+				push(e.bounds, nil, e)
+			}
+		}
+	}
+}
+
+// Nearest returns up to k items nearest to the given point, ordered by distance.
+func (t *Tree[T, V]) Nearest(point geom.Point[T], k int) []V {
+	if t.root == nil || k <= 0 {
+		return nil
+	}
+
+	var q queue[T, V]
+	heap.Push(&q, queued[T, V]{boundsDistance(t.root.bounds, point), t.root, entry[T, V]{}, geom.RayHit[T]{}, false})
+
+	items := make([]V, 0, min(k, t.size))
+	for q.Len() > 0 && len(items) < k {
+		next := heap.Pop(&q).(queued[T, V])
+		if next.node == nil {
+			items = append(items, next.entry.item)
+			continue
+		}
+
+		for _, child := range next.node.children {
+			heap.Push(&q, queued[T, V]{boundsDistance(child.bounds, point), child, entry[T, V]{}, geom.RayHit[T]{}, false})
+		}
+		for _, e := range next.node.entries {
+			heap.Push(&q, queued[T, V]{itemDistance(e, point), nil, e, geom.RayHit[T]{}, false})
+		}
+	}
+
+	return items
+}
+
+// query returns an iterator over items whose bounds pass the test and which pass the item test.
+// Bounds test must pass for any rectangle enclosing passing bounds.
+func (t *Tree[T, V]) query(test func(bounds geom.Rectangle[T]) bool, itemTest func(item V) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if t.root != nil && test(t.root.bounds) {
+			t.root.query(test, itemTest, yield)
+		}
+	}
+}
+
+// query yields items from the node subtree passing both tests.
+func (n *node[T, V]) query(test func(bounds geom.Rectangle[T]) bool, itemTest func(item V) bool, yield func(V) bool) bool {
+	for _, child := range n.children {
+		if test(child.bounds) && !child.query(test, itemTest, yield) {
+			return false
+		}
+	}
+
+	for _, e := range n.entries {
+		if test(e.bounds) && itemTest(e.item) && !yield(e.item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tiles packs elements into groups of at most capacity using Sort-Tile-Recursive:
+// elements are sorted by center X into vertical slices, each slice is sorted by center Y and cut into groups.
+func tiles[E any, T geom.Number](elements []E, bounds func(E) geom.Rectangle[T], capacity int) [][]E {
+	count := int(math.Ceil(float64(len(elements)) / float64(capacity)))
+	sliceSize := int(math.Ceil(math.Sqrt(float64(count)))) * capacity
+
+	sortBy(elements, func(e E) T { return bounds(e).Center.X })
+
+	var groups [][]E
+	for start := 0; start < len(elements); start += sliceSize {
+		slice := elements[start:min(start+sliceSize, len(elements))]
+		sortBy(slice, func(e E) T { return bounds(e).Center.Y })
+
+		for i := 0; i < len(slice); i += capacity {
+			groups = append(groups, slice[i:min(i+capacity, len(slice))])
+		}
+	}
+
+	return groups
+}
+
+// sortBy sorts elements by the given key.
+func sortBy[E any, T geom.Number](elements []E, key func(E) T) {
+	slices.SortFunc(elements, func(a, b E) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// boundsDistance returns distance from the point to the rectangle, zero for points inside.
+func boundsDistance[T geom.Number](bounds geom.Rectangle[T], point geom.Point[T]) float64 {
+	return bounds.Float().Clamp(point.Float()).DistanceTo(point.Float())
+}
+
+// itemDistance returns distance from the point to the item shape if available, otherwise to its bounds.
+func itemDistance[T geom.Number, V geom.Bounded[T]](e entry[T, V], point geom.Point[T]) float64 {
+	if shape, ok := any(e.item).(interface{ SignedDistance(geom.Point[T]) float64 }); ok {
+		return max(shape.SignedDistance(point), 0)
+	}
+
+	return boundsDistance(e.bounds, point)
+}
+
+// queued is a node or entry in a best-first traversal queue.
+type queued[T geom.Number, V geom.Bounded[T]] struct {
+	priority float64
+	node     *node[T, V]
+	entry    entry[T, V]
+	hit      geom.RayHit[T]
+	exact    bool
+}
+
+// queue is a min-heap of queued nodes and entries ordered by priority.
+type queue[T geom.Number, V geom.Bounded[T]] []queued[T, V]
+
+func (q queue[T, V]) Len() int           { return len(q) }
+func (q queue[T, V]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[T, V]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[T, V]) Push(x any)        { *q = append(*q, x.(queued[T, V])) }
+func (q *queue[T, V]) Pop() any {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return x
+}
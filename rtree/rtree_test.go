@@ -0,0 +1,182 @@
+package rtree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+// box is an item known only by its bounds.
+type box struct {
+	bounds geom.Rectangle[int]
+}
+
+func (b box) Bounds() geom.Rectangle[int] {
+	return b.bounds
+}
+
+var triangle = geom.Pol([]geom.Point[float64]{geom.Pt(0.0, 40.0), geom.Pt(10.0, 40.0), geom.Pt(0.0, 50.0)})
+
+var shapeTree = New[float64]([]geom.Shape[float64]{
+	geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(10.0, 10.0)),
+	geom.Ln(geom.Pt(25.0, 0.0), geom.Pt(35.0, 10.0)),
+	geom.RectFromMinMax(geom.Pt(50.0, 0.0), geom.Pt(60.0, 10.0)),
+	triangle,
+	geom.RectFromMinMax(geom.Pt(40.0, 40.0), geom.Pt(50.0, 50.0)),
+}, 2)
+
+func TestNew(t *testing.T) {
+	tree := shapeTree
+
+	assert.Equal(t, tree.Len(), 5)
+	assert.True(t, tree.Bounds().Equal(geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(60.0, 50.0))))
+	assert.True(t, len(tree.root.children) <= 2)
+
+	empty := New[int]([]geom.Rectangle[int]{}, 8)
+	assert.Equal(t, empty.Len(), 0)
+	assert.Equal(t, len(slices.Collect(empty.QueryPoint(geom.Pt(0, 0)))), 0)
+	assert.Equal(t, len(empty.Nearest(geom.Pt(0, 0), 3)), 0)
+}
+
+func TestTree_QueryRect(t *testing.T) {
+	tree := shapeTree
+
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(5.0, 5.0), geom.Pt(26.0, 45.0))))), 3)
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(0.0, 0.0), geom.Pt(60.0, 50.0))))), 5)
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(15.0, 20.0), geom.Pt(35.0, 30.0))))), 0)
+}
+
+func TestTree_QueryPoint(t *testing.T) {
+	tree := shapeTree
+
+	found := slices.Collect(tree.QueryPoint(geom.Pt(2.0, 42.0)))
+	assert.Equal(t, len(found), 1)
+	assert.True(t, found[0].(geom.Polygon[float64]).Equal(triangle))
+	// inside bounds, but outside of the triangle and line
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(9.0, 49.0)))), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(26.0, 5.0)))), 0)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(30.0, 5.0)))), 1)
+}
+
+func TestTree_QueryRay(t *testing.T) {
+	tree := shapeTree
+
+	var times []float64
+	for _, hit := range tree.QueryRay(geom.Ry(geom.Pt(-10.0, 5.0), geom.Vec(1.0, 0.0)), 100) {
+		times = append(times, hit.Time)
+	}
+	// line is hit at its middle, not at its bounds
+	assert.Equal(t, times, []float64{10, 40, 60})
+
+	times = nil
+	for _, hit := range tree.QueryRay(geom.Ry(geom.Pt(-10.0, 5.0), geom.Vec(1.0, 0.0)), 39) {
+		times = append(times, hit.Time)
+	}
+	assert.Equal(t, times, []float64{10})
+
+	var items []geom.Shape[float64]
+	var hits []geom.RayHit[float64]
+	for item, hit := range tree.QueryRay(geom.Ry(geom.Pt(9.0, 60.0), geom.Vec(0.0, -1.0)), 100) {
+		items, hits = append(items, item), append(hits, hit)
+	}
+	assert.Equal(t, len(items), 2)
+	assert.True(t, items[0].(geom.Polygon[float64]).Equal(triangle))
+	geom.AssertPoint(t, hits[0].Point, 9.0, 41.0)
+	geom.AssertVector(t, hits[0].Normal, geom.OneOverSqrt2, geom.OneOverSqrt2)
+	assert.Equal(t, hits[1].Time, 50.0)
+	geom.AssertVector(t, hits[1].Normal, 0.0, 1.0)
+}
+
+func TestTree_QueryRayBounds(t *testing.T) {
+	tree := New[int]([]box{{geom.RectFromMinMax(geom.Pt(10, -5), geom.Pt(20, 5))}}, 2)
+
+	var hits []geom.RayHit[int]
+	for _, hit := range tree.QueryRay(geom.Ry(geom.Pt(0, 0), geom.Vec(1, 0)), 100) {
+		hits = append(hits, hit)
+	}
+	assert.Equal(t, len(hits), 1)
+	assert.Equal(t, hits[0].Time, 10.0)
+	geom.AssertPoint(t, hits[0].Point, 10, 0)
+}
+
+func TestTree_Nearest(t *testing.T) {
+	tree := shapeTree
+
+	nearest := tree.Nearest(geom.Pt(32.0, 22.0), 2)
+	assert.Equal(t, len(nearest), 2)
+	assert.Equal(t, nearest[0], geom.Shape[float64](geom.Ln(geom.Pt(25.0, 0.0), geom.Pt(35.0, 10.0))))
+	assert.Equal(t, nearest[1], geom.Shape[float64](geom.RectFromMinMax(geom.Pt(40.0, 40.0), geom.Pt(50.0, 50.0))))
+
+	assert.Equal(t, len(tree.Nearest(geom.Pt(30.0, 20.0), 10)), 5)
+}
+
+func TestTree_Circles(t *testing.T) {
+	big, small := geom.Circ(geom.Pt(0.0, 0.0), 10.0), geom.Circ(geom.Pt(30.0, 0.0), 5.0)
+	tree := New[float64]([]geom.Circle[float64]{big, small}, 2)
+
+	assert.True(t, tree.Bounds().Equal(geom.RectFromMinMax(geom.Pt(-10.0, -10.0), geom.Pt(35.0, 10.0))))
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(8.0, 0.0))), []geom.Circle[float64]{big})
+	assert.Equal(t, slices.Collect(tree.QueryPoint(geom.Pt(9.0, 9.0))), []geom.Circle[float64](nil))
+	assert.Equal(t, slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(8.0, -1.0), geom.Pt(9.0, 1.0)))), []geom.Circle[float64]{big})
+
+	var times []float64
+	for _, hit := range tree.QueryRay(geom.Ry(geom.Pt(-20.0, 0.0), geom.Vec(1.0, 0.0)), 100) {
+		times = append(times, hit.Time)
+	}
+	assert.Equal(t, times, []float64{10, 45})
+
+	assert.Equal(t, tree.Nearest(geom.Pt(8.0, 12.0), 1), []geom.Circle[float64]{big})
+	assert.Equal(t, tree.Nearest(geom.Pt(22.0, 0.0), 1), []geom.Circle[float64]{small})
+}
+
+func TestTree_Structure(t *testing.T) {
+	rects := make([]geom.Rectangle[int], 0, 100)
+	for y := range 10 {
+		for x := range 10 {
+			rects = append(rects, geom.RectFromMin(geom.Pt(x*10, y*10), geom.Sz(4, 4)))
+		}
+	}
+	tree := New[int](rects, 4)
+
+	assert.Equal(t, tree.Len(), 100)
+	assert.True(t, tree.Bounds().Equal(geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(94, 94))))
+	assert.Equal(t, assertNode(t, tree.root, 4), 100)
+
+	// capacity below 2 is clamped
+	assert.Equal(t, assertNode(t, New[int](rects, 0).root, 2), 100)
+}
+
+// assertNode checks that node bounds enclose its children and entries and that nodes are not over capacity.
+// It returns the number of entries in the subtree.
+func assertNode(t *testing.T, n *node[int, geom.Rectangle[int]], capacity int) int {
+	t.Helper()
+
+	assert.True(t, len(n.children)+len(n.entries) <= capacity)
+
+	count := len(n.entries)
+	for _, e := range n.entries {
+		assert.True(t, n.bounds.Union(e.bounds).Equal(n.bounds))
+	}
+	for _, child := range n.children {
+		assert.True(t, n.bounds.Union(child.bounds).Equal(n.bounds))
+		count += assertNode(t, child, capacity)
+	}
+
+	return count
+}
+
+func TestTree_Edges(t *testing.T) {
+	point := geom.Rect(geom.Pt(10, 10), geom.Sz(0, 0))
+	tree := New[int]([]geom.Rectangle[int]{geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(10, 10)), point}, 2)
+
+	// touching and zero-size bounds collide
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(10, 0), geom.Pt(20, 5))))), 1)
+	assert.Equal(t, len(slices.Collect(tree.QueryPoint(geom.Pt(10, 10)))), 2)
+	assert.Equal(t, len(slices.Collect(tree.QueryRect(geom.RectFromMinMax(geom.Pt(11, 11), geom.Pt(20, 20))))), 0)
+
+	// more neighbours requested than available, point inside bounds has zero distance
+	assert.Equal(t, tree.Nearest(geom.Pt(5, 5), 5), []geom.Rectangle[int]{geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(10, 10)), point})
+	assert.Equal(t, len(tree.Nearest(geom.Pt(5, 5), 0)), 0)
+}
@@ -0,0 +1,222 @@
+// Package sap provides a sweep-and-prune broad-phase over rectangle bounds.
+package sap
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Broadphase keeps interval endpoints of items sorted along the X axis between steps.
+// Insertion sort exploits temporal coherence, so each step costs nearly linear time when items move a little.
+type Broadphase[T geom.Number, V comparable] struct {
+	endpoints []endpoint[T, V]
+	proxies   map[V]*proxy[T, V]
+	nextID    int
+	// overlapsX are pairs with overlapping X intervals, maintained by endpoint swaps.
+	overlapsX map[pairKey]proxyPair[T, V]
+	// overlaps are pairs with colliding bounds reported by the last step.
+	overlaps map[pairKey]proxyPair[T, V]
+}
+
+// Pair is a pair of items with colliding bounds.
+type Pair[V comparable] struct {
+	A, B V
+}
+
+// proxy is an item with its bounds and stable identifier.
+type proxy[T geom.Number, V comparable] struct {
+	id     int
+	item   V
+	bounds geom.Rectangle[T]
+}
+
+// endpoint is start or end of proxy X interval.
+type endpoint[T geom.Number, V comparable] struct {
+	proxy *proxy[T, V]
+	value T
+	min   bool
+}
+
+// pairKey identifies unordered proxy pair by ordered identifiers.
+type pairKey [2]int
+
+// proxyPair is a pair of proxies ordered by identifier.
+type proxyPair[T geom.Number, V comparable] struct {
+	a, b *proxy[T, V]
+}
+
+// New creates an empty Broadphase.
+func New[T geom.Number, V comparable]() *Broadphase[T, V] {
+	return &Broadphase[T, V]{
+		proxies:   make(map[V]*proxy[T, V]),
+		overlapsX: make(map[pairKey]proxyPair[T, V]),
+		overlaps:  make(map[pairKey]proxyPair[T, V]),
+	}
+}
+
+// Insert adds the item with the given bounds, already present item is moved to new bounds.
+// Changes are reflected by the next Step.
+func (b *Broadphase[T, V]) Insert(item V, bounds geom.Rectangle[T]) {
+	if b.Update(item, bounds) {
+		return
+	}
+
+	p := &proxy[T, V]{b.nextID, item, bounds}
+	b.nextID++
+	b.proxies[item] = p
+
+	// endpoints appended after all others do not overlap anything, step sorts them into place
+	b.endpoints = append(b.endpoints, endpoint[T, V]{p, bounds.Min().X, true}, endpoint[T, V]{p, bounds.Max().X, false})
+}
+
+// Remove removes the item, its pairs are reported as removed by the next Step.
+// It returns false if item is not present.
+func (b *Broadphase[T, V]) Remove(item V) bool {
+	p, ok := b.proxies[item]
+	if !ok {
+		return false
+	}
+
+	delete(b.proxies, item)
+	b.endpoints = slices.DeleteFunc(b.endpoints, func(e endpoint[T, V]) bool { return e.proxy == p })
+	maps.DeleteFunc(b.overlapsX, func(key pairKey, _ proxyPair[T, V]) bool { return key[0] == p.id || key[1] == p.id })
+
+	return true
+}
+
+// Update moves the item to new bounds, changes are reflected by the next Step.
+// It returns false if item is not present.
+func (b *Broadphase[T, V]) Update(item V, bounds geom.Rectangle[T]) bool {
+	p, ok := b.proxies[item]
+	if !ok {
+		return false
+	}
+
+	p.bounds = bounds
+
+	return true
+}
+
+// Bounds returns bounds of the item.
+func (b *Broadphase[T, V]) Bounds(item V) (geom.Rectangle[T], bool) {
+	p, ok := b.proxies[item]
+	if !ok {
+		return geom.Rectangle[T]{}, false
+	}
+
+	return p.bounds, true
+}
+
+// Len returns number of items.
+func (b *Broadphase[T, V]) Len() int {
+	return len(b.proxies)
+}
+
+// Step sorts endpoints of moved items and returns pairs which started and stopped colliding since the last step.
+func (b *Broadphase[T, V]) Step() (added, removed []Pair[V]) {
+	for i := range b.endpoints {
+		e := &b.endpoints[i]
+		if e.min {
+			e.value = e.proxy.bounds.Min().X
+		} else {
+			e.value = e.proxy.bounds.Max().X
+		}
+	}
+
+	b.sort()
+
+	overlaps := make(map[pairKey]proxyPair[T, V], len(b.overlaps))
+	for key, pair := range b.overlapsX {
+		if geom.CollisionRectangles(pair.a.bounds, pair.b.bounds) {
+			overlaps[key] = pair
+		}
+	}
+
+	for _, key := range sortedKeys(overlaps) {
+		if _, ok := b.overlaps[key]; !ok {
+			added = append(added, overlaps[key].pair())
+		}
+	}
+	for _, key := range sortedKeys(b.overlaps) {
+		if _, ok := overlaps[key]; !ok {
+			removed = append(removed, b.overlaps[key].pair())
+		}
+	}
+
+	b.overlaps = overlaps
+
+	return added, removed
+}
+
+// Pairs returns an iterator over pairs of items with colliding bounds as of the last step.
+func (b *Broadphase[T, V]) Pairs() iter.Seq2[V, V] {
+	return func(yield func(V, V) bool) {
+		for _, key := range sortedKeys(b.overlaps) {
+			pair := b.overlaps[key]
+			if !yield(pair.a.item, pair.b.item) {
+				return
+			}
+		}
+	}
+}
+
+// sort performs insertion sort of endpoints, updating X overlaps on each swap of start and end endpoints.
+func (b *Broadphase[T, V]) sort() {
+	for i := 1; i < len(b.endpoints); i++ {
+		e := b.endpoints[i]
+
+		j := i
+		for ; j > 0 && less(e, b.endpoints[j-1]); j-- {
+			other := b.endpoints[j-1]
+			b.endpoints[j] = other
+
+			if e.proxy == other.proxy || e.min == other.min {
+				continue
+			}
+
+			if e.min {
+				// start moved before other end
+				b.overlapsX[key(e.proxy, other.proxy)] = newProxyPair(e.proxy, other.proxy)
+			} else {
+				// end moved before other start
+				delete(b.overlapsX, key(e.proxy, other.proxy))
+			}
+		}
+		b.endpoints[j] = e
+	}
+}
+
+// less orders endpoints by value, start endpoints go first on tie, so touching intervals overlap.
+func less[T geom.Number, V comparable](e1, e2 endpoint[T, V]) bool {
+	return e1.value < e2.value || (e1.value == e2.value && e1.min && !e2.min)
+}
+
+// key returns key of unordered proxy pair.
+func key[T geom.Number, V comparable](p1, p2 *proxy[T, V]) pairKey {
+	return pairKey{min(p1.id, p2.id), max(p1.id, p2.id)}
+}
+
+// newProxyPair creates proxy pair ordered by identifier.
+func newProxyPair[T geom.Number, V comparable](p1, p2 *proxy[T, V]) proxyPair[T, V] {
+	if p1.id > p2.id {
+		p1, p2 = p2, p1
+	}
+
+	return proxyPair[T, V]{p1, p2}
+}
+
+// pair returns items of proxy pair.
+func (p proxyPair[T, V]) pair() Pair[V] {
+	return Pair[V]{p.a.item, p.b.item}
+}
+
+// sortedKeys returns pair keys in deterministic order.
+func sortedKeys[T geom.Number, V comparable](pairs map[pairKey]proxyPair[T, V]) []pairKey {
+	return slices.SortedFunc(maps.Keys(pairs), func(k1, k2 pairKey) int {
+		return cmp.Or(cmp.Compare(k1[0], k2[0]), cmp.Compare(k1[1], k2[1]))
+	})
+}
@@ -0,0 +1,116 @@
+package sap
+
+import (
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+func TestBroadphase_Step(t *testing.T) {
+	broadphase := New[int, string]()
+	broadphase.Insert("a", geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(10, 10)))
+	broadphase.Insert("b", geom.RectFromMinMax(geom.Pt(5, 5), geom.Pt(15, 15)))
+	broadphase.Insert("c", geom.RectFromMinMax(geom.Pt(5, 20), geom.Pt(15, 30)))
+
+	added, removed := broadphase.Step()
+	assert.Equal(t, added, []Pair[string]{{"a", "b"}})
+	assert.Equal(t, len(removed), 0)
+
+	// no changes
+	added, removed = broadphase.Step()
+	assert.Equal(t, len(added), 0)
+	assert.Equal(t, len(removed), 0)
+
+	// overlapping on X, separating on Y
+	broadphase.Update("b", geom.RectFromMinMax(geom.Pt(5, 12), geom.Pt(15, 22)))
+	added, removed = broadphase.Step()
+	assert.Equal(t, added, []Pair[string]{{"b", "c"}})
+	assert.Equal(t, removed, []Pair[string]{{"a", "b"}})
+
+	// touching bounds collide
+	broadphase.Update("a", geom.RectFromMinMax(geom.Pt(15, 25), geom.Pt(25, 35)))
+	added, removed = broadphase.Step()
+	assert.Equal(t, added, []Pair[string]{{"a", "c"}})
+	assert.Equal(t, len(removed), 0)
+
+	count := 0
+	for item1, item2 := range broadphase.Pairs() {
+		assert.True(t, item1 < item2)
+		count++
+	}
+	assert.Equal(t, count, 2)
+}
+
+func TestBroadphase_Remove(t *testing.T) {
+	broadphase := New[int, int]()
+	broadphase.Insert(1, geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(10, 10)))
+	broadphase.Insert(2, geom.RectFromMinMax(geom.Pt(5, 5), geom.Pt(15, 15)))
+	broadphase.Step()
+
+	assert.True(t, broadphase.Remove(2))
+	assert.False(t, broadphase.Remove(2))
+	assert.Equal(t, broadphase.Len(), 1)
+
+	added, removed := broadphase.Step()
+	assert.Equal(t, len(added), 0)
+	assert.Equal(t, removed, []Pair[int]{{1, 2}})
+
+	_, ok := broadphase.Bounds(2)
+	assert.False(t, ok)
+	assert.False(t, broadphase.Update(2, geom.RectFromMinMax(geom.Pt(5, 5), geom.Pt(15, 15))))
+}
+
+func TestBroadphase_Empty(t *testing.T) {
+	broadphase := New[int, int]()
+
+	added, removed := broadphase.Step()
+	assert.Equal(t, len(added), 0)
+	assert.Equal(t, len(removed), 0)
+	assert.Equal(t, broadphase.Len(), 0)
+	assert.False(t, broadphase.Remove(1))
+
+	for range broadphase.Pairs() {
+		assert.Fail(t, "empty broadphase has no pairs")
+	}
+}
+
+func TestBroadphase_Jump(t *testing.T) {
+	broadphase := New[int, int]()
+	for i := range 5 {
+		broadphase.Insert(i, geom.RectFromMin(geom.Pt(i*10, 0), geom.Sz(4, 4)))
+	}
+	broadphase.Insert(5, geom.RectFromMin(geom.Pt(-20, 0), geom.Sz(4, 4)))
+	added, _ := broadphase.Step()
+	assert.Equal(t, len(added), 0)
+
+	// moving over all other items in one step keeps their intervals sorted
+	broadphase.Update(5, geom.RectFromMin(geom.Pt(42, 2), geom.Sz(4, 4)))
+	added, removed := broadphase.Step()
+	assert.Equal(t, added, []Pair[int]{{4, 5}})
+	assert.Equal(t, len(removed), 0)
+
+	broadphase.Update(5, geom.RectFromMin(geom.Pt(-20, 0), geom.Sz(4, 4)))
+	added, removed = broadphase.Step()
+	assert.Equal(t, len(added), 0)
+	assert.Equal(t, removed, []Pair[int]{{4, 5}})
+}
+
+func TestBroadphase_Edges(t *testing.T) {
+	broadphase := New[int, int]()
+	// zero-size items at the same point and items with identical X intervals collide
+	broadphase.Insert(1, geom.Rect(geom.Pt(5, 5), geom.Sz(0, 0)))
+	broadphase.Insert(2, geom.Rect(geom.Pt(5, 5), geom.Sz(0, 0)))
+	broadphase.Insert(3, geom.RectFromMinMax(geom.Pt(20, 0), geom.Pt(30, 10)))
+	broadphase.Insert(4, geom.RectFromMinMax(geom.Pt(20, 10), geom.Pt(30, 20)))
+
+	added, _ := broadphase.Step()
+	assert.Equal(t, added, []Pair[int]{{1, 2}, {3, 4}})
+
+	// reinserted item is a new proxy, so its pairs are reported as removed and added again
+	assert.True(t, broadphase.Remove(2))
+	broadphase.Insert(2, geom.Rect(geom.Pt(5, 5), geom.Sz(0, 0)))
+	added, removed := broadphase.Step()
+	assert.Equal(t, added, []Pair[int]{{1, 2}})
+	assert.Equal(t, removed, []Pair[int]{{1, 2}})
+}
@@ -0,0 +1,102 @@
+package geom
+
+// Bounded is anything with axis-aligned bounding rectangle.
+type Bounded[T Number] interface {
+	// Bounds returns the axis-aligned bounding rectangle.
+	Bounds() Rectangle[T]
+}
+
+// Shape is a common interface of all 2D shapes (Circle, Rectangle, Line, Polygon and RegularPolygon).
+type Shape[T Number] interface {
+	Convex
+	Bounded[T]
+	// Contains checks if the given point lies inside the shape.
+	Contains(point Point[T]) bool
+	// Centroid returns the center point of the shape.
+	Centroid() Point[T]
+	// TranslateShape creates a new shape of the same type translated by the given vector.
+	TranslateShape(vector Vector[T]) Shape[T]
+}
+
+var (
+	_ Shape[int] = Circle[int]{}
+	_ Shape[int] = Rectangle[int]{}
+	_ Shape[int] = Line[int]{}
+	_ Shape[int] = Polygon[int]{}
+	_ Shape[int] = RegularPolygon[int]{}
+)
+
+// Collide checks if the given shapes collide using the most specific collision test available for the pair.
+// Concave polygons are tested edge by edge, other shapes defined outside the package are expected to be convex.
+func Collide[T Number](shape1, shape2 Shape[T]) bool {
+	shape1, shape2 = polygonShape(shape1), polygonShape(shape2)
+
+	if polygon, ok := shape1.(Polygon[T]); ok && !polygon.IsConvex() {
+		return collideConcave(polygon, shape2)
+	}
+	if polygon, ok := shape2.(Polygon[T]); ok && !polygon.IsConvex() {
+		return collideConcave(polygon, shape1)
+	}
+
+	switch s1 := shape1.(type) {
+	case Rectangle[T]:
+		switch s2 := shape2.(type) {
+		case Rectangle[T]:
+			return CollisionRectangles(s1, s2)
+		case Circle[T]:
+			return CollisionRectangleCircle(s1, s2)
+		case Polygon[T]:
+			_, ok := CollisionPolygonRectangle(s2, s1)
+			return ok
+		}
+	case Circle[T]:
+		switch s2 := shape2.(type) {
+		case Rectangle[T]:
+			return CollisionRectangleCircle(s2, s1)
+		case Circle[T]:
+			return CollisionCircles(s1, s2)
+		case Polygon[T]:
+			_, ok := CollisionPolygonCircle(s2, s1)
+			return ok
+		}
+	case Polygon[T]:
+		switch s2 := shape2.(type) {
+		case Rectangle[T]:
+			_, ok := CollisionPolygonRectangle(s1, s2)
+			return ok
+		case Circle[T]:
+			_, ok := CollisionPolygonCircle(s1, s2)
+			return ok
+		case Polygon[T]:
+			_, ok := CollisionPolygons(s1, s2)
+			return ok
+		}
+	}
+
+	return CollisionConvex(shape1, shape2)
+}
+
+// polygonShape converts regular polygon to a generic polygon, other shapes are returned unchanged.
+func polygonShape[T Number](shape Shape[T]) Shape[T] {
+	if polygon, ok := shape.(RegularPolygon[T]); ok {
+		return polygon.Polygon()
+	}
+
+	return shape
+}
+
+// collideConcave checks if the given concave polygon and shape collide.
+// Shapes collide when their boundaries intersect or when one of them lies inside the other.
+func collideConcave[T Number](polygon Polygon[T], shape Shape[T]) bool {
+	if len(polygon.Vertices) == 0 {
+		return false
+	}
+
+	for i, vertex := range polygon.Vertices {
+		if Collide[T](Ln(vertex, polygon.Vertices[(i+1)%len(polygon.Vertices)]), shape) {
+			return true
+		}
+	}
+
+	return polygonContains(polygon.Float().Vertices, shape.Support(Vector[float64]{1, 0})) || shape.Contains(polygon.Vertices[0])
+}
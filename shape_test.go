@@ -0,0 +1,69 @@
+package geom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestShape_Centroid(t *testing.T) {
+	AssertPoint(t, Shape[int](circleInt).Centroid(), 1, 2)
+	AssertPoint(t, Shape[int](Rect(Pt(3, 4), Sz(2, 2))).Centroid(), 3, 4)
+	AssertPoint(t, Shape[int](Ln(Pt(0, 0), Pt(4, 2))).Centroid(), 2, 1)
+	AssertPoint(t, Shape[int](polygonInt).Centroid(), 1, 1)
+	AssertPoint(t, Shape[int](regPolygonInt).Centroid(), 1, 2)
+}
+
+func TestShape_TranslateShape(t *testing.T) {
+	shapes := []Shape[int]{circleInt, Rect(Pt(3, 4), Sz(2, 2)), Ln(Pt(0, 0), Pt(4, 2)), polygonInt, regPolygonInt}
+
+	for _, shape := range shapes {
+		translated := shape.TranslateShape(Vec(2, -1))
+
+		assert.Equal(t, fmt.Sprintf("%T", translated), fmt.Sprintf("%T", shape))
+		assert.Equal(t, translated.Centroid(), shape.Centroid().Add(Vec(2, -1)))
+		assert.Equal(t, translated.Bounds().Size, shape.Bounds().Size)
+	}
+}
+
+func TestCollide(t *testing.T) {
+	square := Pol([]Point[float64]{{0, 0}, {2, 0}, {2, 2}, {0, 2}})
+	hexagon := Hexagon(Pt(0.0, 0.0), SzU(1.0), FlatTop)
+	// U shape open to the bottom with a notch between x 1 and 2 reaching y 1
+	concave := Pol([]Point[float64]{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}})
+
+	cases := []struct {
+		shape1, shape2 Shape[float64]
+		collide        bool
+	}{
+		{Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)), Rect(Pt(1.5, 0.0), Sz(2.0, 2.0)), true},
+		{Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)), Rect(Pt(2.5, 0.0), Sz(2.0, 2.0)), false},
+		{Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)), Circ(Pt(1.5, 0.0), 1.0), true},
+		{Circ(Pt(3.0, 0.0), 1.0), Rect(Pt(0.0, 0.0), Sz(2.0, 2.0)), false},
+		{Circ(Pt(0.0, 0.0), 1.0), Circ(Pt(1.5, 0.0), 1.0), true},
+		{Circ(Pt(3.0, 1.0), 1.5), square, true},
+		{square, Circ(Pt(5.0, 1.0), 1.0), false},
+		{square, Rect(Pt(2.5, 1.0), Sz(2.0, 2.0)), true},
+		{Rect(Pt(4.0, 1.0), Sz(2.0, 2.0)), square, false},
+		{square, square.Translate(Vec(1.0, 1.0)), true},
+		{hexagon, hexagon.Translate(Vec(0.0, 1.5)), true},
+		{hexagon, square.Translate(Vec(3.0, 0.0)), false},
+		{Ln(Pt(-1.0, 1.0), Pt(3.0, 1.0)), square, true},
+		{Ln(Pt(-1.0, 3.0), Pt(3.0, 3.0)), Circ(Pt(1.0, 1.0), 1.0), false},
+		{concave, Circ(Pt(1.5, 2.5), 0.4), false},
+		{Circ(Pt(1.5, 2.5), 0.6), concave, true},
+		{concave, Rect(Pt(1.5, 2.0), Sz(0.8, 1.6)), false},
+		{concave, Rect(Pt(1.5, 2.0), Sz(1.2, 1.6)), true},
+		{concave, Ln(Pt(1.5, 1.5), Pt(1.5, 4.0)), false},
+		{concave, RegPol(Pt(1.5, 2.5), SzU(0.4), 6, 0), false},
+		{concave, Circ(Pt(0.5, 0.5), 0.2), true},
+		{Rect(Pt(1.5, 1.5), Sz(6.0, 6.0)), concave, true},
+		{concave, concave.Translate(Vec(3.5, 0.0)), false},
+		{concave, concave.Translate(Vec(2.5, 0.0)), true},
+	}
+
+	for i, c := range cases {
+		assert.Equal(t, Collide(c.shape1, c.shape2), c.collide, fmt.Sprintf("#%d", i))
+	}
+}
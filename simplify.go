@@ -0,0 +1,288 @@
+package geom
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// SimplifyMethod is the algorithm used to remove vertices of polygons and paths.
+type SimplifyMethod int
+
+const (
+	// DouglasPeucker keeps vertices farther than tolerance from the simplified path (Ramer–Douglas–Peucker).
+	DouglasPeucker SimplifyMethod = iota
+	// Visvalingam removes vertices forming triangles with neighbours smaller than tolerance squared (Visvalingam–Whyatt).
+	Visvalingam
+)
+
+// Simplify creates a new Polygon with subset of vertices approximating the polygon within the given tolerance.
+// When preserveTopology is true, vertices are kept so that edges of the (simple) polygon do not intersect.
+func (p Polygon[T]) Simplify(tolerance float64, method SimplifyMethod, preserveTopology bool) Polygon[T] {
+	return Polygon[T]{simplify(p.Vertices, tolerance, method, preserveTopology, true)}
+}
+
+// SimplifyPath returns subset of points (including the first and the last one) approximating the open path within the given tolerance.
+// When preserveTopology is true, points are kept so that segments of the (non-self-intersecting) path do not intersect.
+func SimplifyPath[T Number](points []Point[T], tolerance float64, method SimplifyMethod, preserveTopology bool) []Point[T] {
+	return simplify(points, tolerance, method, preserveTopology, false)
+}
+
+// simplify returns kept points of the open path or closed ring.
+func simplify[T Number](points []Point[T], tolerance float64, method SimplifyMethod, preserveTopology, closed bool) []Point[T] {
+	minimum := 2
+	if closed {
+		minimum = 3
+	}
+
+	if len(points) <= minimum {
+		return slices.Clone(points)
+	}
+
+	vertices := make([]Point[float64], len(points))
+	for i, point := range points {
+		vertices[i] = point.Float()
+	}
+
+	var keep []bool
+	switch method {
+	case Visvalingam:
+		keep = visvalingam(vertices, tolerance*tolerance, closed, minimum)
+	default:
+		keep = douglasPeucker(vertices, tolerance, closed)
+	}
+
+	if preserveTopology {
+		keepNonIntersecting(vertices, keep, closed)
+	}
+
+	simplified := make([]Point[T], 0, len(points))
+	for i, point := range points {
+		if keep[i] {
+			simplified = append(simplified, point)
+		}
+	}
+
+	return simplified
+}
+
+// douglasPeucker marks vertices kept by Ramer–Douglas–Peucker algorithm.
+// Ring is split at its first vertex and the vertex farthest from it, and at least 3 of its vertices are kept.
+func douglasPeucker(vertices []Point[float64], tolerance float64, closed bool) []bool {
+	n := len(vertices)
+	keep := make([]bool, n)
+	keep[0] = true
+
+	if !closed {
+		keep[n-1] = true
+		douglasPeuckerRange(vertices, 0, n-1, tolerance, keep)
+
+		return keep
+	}
+
+	far := 0
+	for i, vertex := range vertices {
+		if vertex.DistanceSquaredTo(vertices[0]) > vertices[far].DistanceSquaredTo(vertices[0]) {
+			far = i
+		}
+	}
+	keep[far] = true
+
+	douglasPeuckerRange(vertices, 0, far, tolerance, keep)
+	douglasPeuckerRange(vertices, far, n, tolerance, keep)
+
+	if count := countKept(keep); count < 3 {
+		// keep the vertex farthest from the split segment
+		index1, distance1 := farthestVertex(vertices, 0, far)
+		index2, distance2 := farthestVertex(vertices, far, n)
+		if distance1 >= distance2 {
+			keep[index1] = true
+		} else {
+			keep[index2] = true
+		}
+	}
+
+	return keep
+}
+
+// douglasPeuckerRange marks vertices between first and last farther than tolerance from the simplified path.
+func douglasPeuckerRange(vertices []Point[float64], first, last int, tolerance float64, keep []bool) {
+	if index, distance := farthestVertex(vertices, first, last); index >= 0 && distance > tolerance {
+		keep[index] = true
+		douglasPeuckerRange(vertices, first, index, tolerance, keep)
+		douglasPeuckerRange(vertices, index, last, tolerance, keep)
+	}
+}
+
+// farthestVertex returns vertex between first and last (indices modulo vertices count) farthest from their segment.
+// Index is -1 if there is no vertex between them.
+func farthestVertex(vertices []Point[float64], first, last int) (int, float64) {
+	n := len(vertices)
+	start, end := vertices[first%n], vertices[last%n]
+
+	index, distance := -1, -1.0
+	for i := first + 1; i < last; i++ {
+		if d := projectSegment(start, end, vertices[i%n]).DistanceTo(vertices[i%n]); d > distance {
+			index, distance = i%n, d
+		}
+	}
+
+	return index, distance
+}
+
+// visvalingam marks vertices kept by Visvalingam–Whyatt algorithm, removing vertices with the smallest effective area
+// (area of triangle with their neighbours) while it is less than threshold and more than minimum vertices remain.
+func visvalingam(vertices []Point[float64], threshold float64, closed bool, minimum int) []bool {
+	n := len(vertices)
+	prev, next := make([]int, n), make([]int, n)
+	for i := range vertices {
+		prev[i], next[i] = (i+n-1)%n, (i+1)%n
+	}
+
+	keep := make([]bool, n)
+	areas := make([]float64, n)
+	for i := range vertices {
+		keep[i] = true
+		areas[i] = triangleArea(vertices[prev[i]], vertices[i], vertices[next[i]])
+	}
+	if !closed {
+		areas[0], areas[n-1] = math.Inf(1), math.Inf(1)
+	}
+
+	candidates := make(areaQueue, n)
+	for i, area := range areas {
+		candidates[i] = areaCandidate{area, i}
+	}
+	heap.Init(&candidates)
+
+	for remaining := n; remaining > minimum && candidates.Len() > 0; {
+		candidate := heap.Pop(&candidates).(areaCandidate)
+		if !keep[candidate.index] || candidate.area != areas[candidate.index] {
+			// outdated candidate
+			continue
+		}
+		if candidate.area >= threshold {
+			break
+		}
+
+		keep[candidate.index] = false
+		remaining--
+
+		before, after := prev[candidate.index], next[candidate.index]
+		next[before], prev[after] = after, before
+
+		// neighbour area is never smaller than area of removed vertex, so vertices are removed in order of significance
+		for _, neighbour := range []int{before, after} {
+			if !math.IsInf(areas[neighbour], 1) {
+				areas[neighbour] = max(candidate.area, triangleArea(vertices[prev[neighbour]], vertices[neighbour], vertices[next[neighbour]]))
+				heap.Push(&candidates, areaCandidate{areas[neighbour], neighbour})
+			}
+		}
+	}
+
+	return keep
+}
+
+// triangleArea returns (unsigned) area of the triangle.
+func triangleArea(a, b, c Point[float64]) float64 {
+	return math.Abs(b.Subtract(a).Cross(c.Subtract(a))) / 2
+}
+
+// keepNonIntersecting marks additional vertices until segments between kept vertices do not intersect.
+// Each intersecting segment is split at its farthest removed vertex, segments of the original path are left as they are.
+func keepNonIntersecting(vertices []Point[float64], keep []bool, closed bool) {
+	for {
+		var indices []int
+		for i, kept := range keep {
+			if kept {
+				indices = append(indices, i)
+			}
+		}
+
+		// segments as pairs of indices, the closing one of ring ends after the last vertex
+		segments := make([][2]int, 0, len(indices))
+		for k := 0; k+1 < len(indices); k++ {
+			segments = append(segments, [2]int{indices[k], indices[k+1]})
+		}
+		if closed {
+			segments = append(segments, [2]int{indices[len(indices)-1], indices[0] + len(vertices)})
+		}
+
+		intersecting := make([]bool, len(segments))
+		for i := range segments {
+			for j := i + 1; j < len(segments); j++ {
+				if segmentsIntersect(vertices, segments[i], segments[j]) {
+					intersecting[i], intersecting[j] = true, true
+				}
+			}
+		}
+
+		changed := false
+		for i, segment := range segments {
+			if index, _ := farthestVertex(vertices, segment[0], segment[1]); intersecting[i] && index >= 0 {
+				keep[index] = true
+				changed = true
+			}
+		}
+
+		if !changed {
+			return
+		}
+	}
+}
+
+// segmentsIntersect checks if segments (given by indices of their vertices modulo vertices count) intersect
+// anywhere other than at their shared vertex.
+func segmentsIntersect(vertices []Point[float64], segment1, segment2 [2]int) bool {
+	n := len(vertices)
+	a, b := segment1[0]%n, segment1[1]%n
+	c, d := segment2[0]%n, segment2[1]%n
+
+	// adjacent segments intersect only when they overlap
+	switch {
+	case b == c && a != d:
+		return orientation(vertices[a], vertices[b], vertices[d]) == 0 && vertices[a].Subtract(vertices[b]).Dot(vertices[d].Subtract(vertices[b])) > 0
+	case d == a && b != c:
+		return orientation(vertices[c], vertices[a], vertices[b]) == 0 && vertices[c].Subtract(vertices[a]).Dot(vertices[b].Subtract(vertices[a])) > 0
+	case b == c && a == d:
+		// two segments between the same vertices
+		return true
+	}
+
+	_, _, ok := intersectSegments(Ln(vertices[a], vertices[b]), Ln(vertices[c], vertices[d]))
+
+	return ok
+}
+
+// countKept returns number of kept vertices.
+func countKept(keep []bool) int {
+	count := 0
+	for _, kept := range keep {
+		if kept {
+			count++
+		}
+	}
+
+	return count
+}
+
+// areaCandidate is a vertex with its effective area.
+type areaCandidate struct {
+	area  float64
+	index int
+}
+
+// areaQueue is a min-heap of vertices ordered by effective area.
+type areaQueue []areaCandidate
+
+func (q areaQueue) Len() int           { return len(q) }
+func (q areaQueue) Less(i, j int) bool { return q[i].area < q[j].area }
+func (q areaQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *areaQueue) Push(x any)        { *q = append(*q, x.(areaCandidate)) }
+func (q *areaQueue) Pop() any {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return x
+}
@@ -0,0 +1,114 @@
+package geom
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestSimplifyPath(t *testing.T) {
+	path := []Point[float64]{Pt(0.0, 0.0), Pt(1.0, 0.1), Pt(2.0, -0.1), Pt(3.0, 0.0), Pt(3.1, 1.0), Pt(2.9, 2.0), Pt(3.0, 3.0)}
+	expected := []Point[float64]{Pt(0.0, 0.0), Pt(3.0, 0.0), Pt(3.0, 3.0)}
+
+	AssertVertices(t, SimplifyPath(path, 0.5, DouglasPeucker, false), expected)
+	AssertVertices(t, SimplifyPath(path, 0.5, Visvalingam, false), expected)
+	AssertVertices(t, SimplifyPath(path, 0.5, DouglasPeucker, true), expected)
+	AssertVertices(t, SimplifyPath(path, 0.05, DouglasPeucker, false), path)
+	AssertVertices(t, SimplifyPath(path, 0.05, Visvalingam, false), path)
+	AssertVertices(t, SimplifyPath(path, 10, DouglasPeucker, false), []Point[float64]{Pt(0.0, 0.0), Pt(3.0, 3.0)})
+	AssertVertices(t, SimplifyPath(path, 10, Visvalingam, false), []Point[float64]{Pt(0.0, 0.0), Pt(3.0, 3.0)})
+
+	// short paths are kept
+	AssertVertices(t, SimplifyPath([]Point[int]{Pt(0, 0), Pt(1, 1)}, 10, DouglasPeucker, false), []Point[int]{Pt(0, 0), Pt(1, 1)})
+	assert.Length(t, SimplifyPath([]Point[int]{}, 10, Visvalingam, false), 0)
+}
+
+func TestPolygon_Simplify(t *testing.T) {
+	// square with vertices along its edges
+	polygon := Pol([]Point[int]{
+		Pt(0, 0), Pt(5, 1), Pt(10, 0), Pt(20, 0), Pt(20, 10), Pt(19, 15), Pt(20, 20), Pt(10, 21), Pt(0, 20), Pt(1, 10),
+	})
+	expected := []Point[int]{Pt(0, 0), Pt(20, 0), Pt(20, 20), Pt(0, 20)}
+
+	AssertPolygon(t, polygon.Simplify(2, DouglasPeucker, false), expected)
+	AssertPolygon(t, polygon.Simplify(4, Visvalingam, false), expected)
+	AssertPolygon(t, polygon.Simplify(2, DouglasPeucker, true), expected)
+	AssertPolygon(t, polygon.Simplify(0.5, DouglasPeucker, false), polygon.Vertices)
+	AssertPolygon(t, polygon.Simplify(0.5, Visvalingam, false), polygon.Vertices)
+
+	// at least a triangle is kept
+	assert.Length(t, polygon.Simplify(100, DouglasPeucker, false).Vertices, 3)
+	assert.Length(t, polygon.Simplify(100, Visvalingam, false).Vertices, 3)
+	assert.Length(t, polygon.Simplify(100, Visvalingam, true).Vertices, 3)
+}
+
+func TestPolygon_SimplifyTopology(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	// meandering band with noise along its edges, simplifying it can cut across neighbouring columns
+	meander := MultiPolygon[float64]{}
+	for i := range 8 {
+		meander = meander.Union(MultiPol(RectFromMinMax(Pt(2.0*float64(i), 0.0), Pt(2.0*float64(i)+1, 10.0)).Polygon()))
+		if i < 7 {
+			y := 9.0 * float64(i%2)
+			meander = meander.Union(MultiPol(RectFromMinMax(Pt(2.0*float64(i), y), Pt(2.0*float64(i)+3, y+1)).Polygon()))
+		}
+	}
+	assert.Length(t, meander.Polygons, 1)
+
+	intersecting := 0
+	for range 50 {
+		var vertices []Point[float64]
+		corners := meander.Polygons[0].Vertices
+		for i, corner := range corners {
+			direction := corners[(i+1)%len(corners)].Subtract(corner)
+			steps := int(direction.Length() / 0.25)
+			for k := range steps {
+				jitter := direction.Normal().Normalize().Multiply((random.Float64() - 0.5) * 0.4)
+				if k == 0 {
+					jitter = Vector[float64]{}
+				}
+				vertices = append(vertices, corner.Add(direction.Multiply(float64(k)/float64(steps)).Add(jitter)))
+			}
+		}
+		polygon := Pol(vertices)
+		assert.False(t, polygonSelfIntersects(polygon))
+
+		for _, method := range []SimplifyMethod{DouglasPeucker, Visvalingam} {
+			tolerance := 1.5 + random.Float64()*2
+
+			if polygonSelfIntersects(polygon.Simplify(tolerance, method, false)) {
+				intersecting++
+			}
+
+			simplified := polygon.Simplify(tolerance, method, true)
+			assert.False(t, polygonSelfIntersects(simplified))
+			assert.True(t, len(simplified.Vertices) >= 3)
+		}
+	}
+
+	// simplification without preserving topology creates self-intersections
+	assert.True(t, intersecting > 0)
+}
+
+// polygonSelfIntersects checks if any non-adjacent polygon edges intersect.
+func polygonSelfIntersects(polygon Polygon[float64]) bool {
+	n := len(polygon.Vertices)
+	edge := func(i int) Line[float64] {
+		return Ln(polygon.Vertices[i], polygon.Vertices[(i+1)%n])
+	}
+
+	for i := range n {
+		for j := i + 2; j < n; j++ {
+			if (j+1)%n == i {
+				continue
+			}
+			if _, ok := edge(i).Intersect(edge(j)); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
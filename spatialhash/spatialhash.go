@@ -0,0 +1,206 @@
+// Package spatialhash provides a uniform grid spatial hash of items keyed by their rectangle bounds.
+package spatialhash
+
+import (
+	"iter"
+	"math"
+	"slices"
+
+	geom "github.com/gravitton/geometry"
+)
+
+// Grid is a spatial hash storing items in all integer cells overlapped by their bounds.
+// It works best for many items of similar size no bigger than the cell size.
+type Grid[T geom.Number, V comparable] struct {
+	cellSize geom.Size[T]
+	cells    map[geom.Point[int]][]V
+	items    map[V]entry[T]
+}
+
+// entry is item bounds with the range of cells it occupies.
+type entry[T geom.Number] struct {
+	bounds           geom.Rectangle[T]
+	minCell, maxCell geom.Point[int]
+}
+
+// New creates an empty Grid with the given cell size, non-positive cell width or height is replaced by 1.
+func New[T geom.Number, V comparable](cellSize geom.Size[T]) *Grid[T, V] {
+	if !(cellSize.Width > 0) {
+		cellSize.Width = 1
+	}
+	if !(cellSize.Height > 0) {
+		cellSize.Height = 1
+	}
+
+	return &Grid[T, V]{
+		cellSize: cellSize,
+		cells:    make(map[geom.Point[int]][]V),
+		items:    make(map[V]entry[T]),
+	}
+}
+
+// Cell returns coordinates of the cell containing the given point.
+func (g *Grid[T, V]) Cell(point geom.Point[T]) geom.Point[int] {
+	return geom.Pt(
+		int(math.Floor(float64(point.X)/float64(g.cellSize.Width))),
+		int(math.Floor(float64(point.Y)/float64(g.cellSize.Height))),
+	)
+}
+
+// CellBounds returns rectangle covered by the given cell.
+func (g *Grid[T, V]) CellBounds(cell geom.Point[int]) geom.Rectangle[T] {
+	return geom.RectFromMin(geom.Pt(T(cell.X)*g.cellSize.Width, T(cell.Y)*g.cellSize.Height), g.cellSize)
+}
+
+// Insert adds the item with the given bounds, already present item is moved to new bounds.
+func (g *Grid[T, V]) Insert(item V, bounds geom.Rectangle[T]) {
+	if g.Update(item, bounds) {
+		return
+	}
+
+	e := g.entry(bounds)
+	g.items[item] = e
+	g.add(item, e.minCell, e.maxCell, nil)
+}
+
+// Remove removes the item from all its cells, returns false if item is not present.
+func (g *Grid[T, V]) Remove(item V) bool {
+	e, ok := g.items[item]
+	if !ok {
+		return false
+	}
+
+	delete(g.items, item)
+	g.remove(item, e.minCell, e.maxCell, nil)
+
+	return true
+}
+
+// Update moves the item to new bounds, touching only cells it enters or leaves.
+// It returns false if item is not present.
+func (g *Grid[T, V]) Update(item V, bounds geom.Rectangle[T]) bool {
+	old, ok := g.items[item]
+	if !ok {
+		return false
+	}
+
+	e := g.entry(bounds)
+	g.items[item] = e
+
+	if e.minCell != old.minCell || e.maxCell != old.maxCell {
+		g.remove(item, old.minCell, old.maxCell, &e)
+		g.add(item, e.minCell, e.maxCell, &old)
+	}
+
+	return true
+}
+
+// Bounds returns bounds of the item.
+func (g *Grid[T, V]) Bounds(item V) (geom.Rectangle[T], bool) {
+	e, ok := g.items[item]
+
+	return e.bounds, ok
+}
+
+// Len returns number of items.
+func (g *Grid[T, V]) Len() int {
+	return len(g.items)
+}
+
+// Clear removes all items.
+func (g *Grid[T, V]) Clear() {
+	clear(g.cells)
+	clear(g.items)
+}
+
+// QueryRect returns an iterator over items whose bounds collide with the given rectangle.
+func (g *Grid[T, V]) QueryRect(rect geom.Rectangle[T]) iter.Seq[V] {
+	return g.query(rect, func(bounds geom.Rectangle[T]) bool {
+		return geom.CollisionRectangles(bounds, rect)
+	})
+}
+
+// QueryCircle returns an iterator over items whose bounds collide with the given circle.
+func (g *Grid[T, V]) QueryCircle(circle geom.Circle[T]) iter.Seq[V] {
+	return g.query(geom.Rect(circle.Center, geom.Sz(circle.Diameter(), circle.Diameter())), func(bounds geom.Rectangle[T]) bool {
+		return geom.CollisionRectangleCircle(bounds, circle)
+	})
+}
+
+// QueryPoint returns an iterator over items whose bounds contain the given point.
+func (g *Grid[T, V]) QueryPoint(point geom.Point[T]) iter.Seq[V] {
+	return g.query(geom.Rect(point, geom.Size[T]{}), func(bounds geom.Rectangle[T]) bool {
+		return bounds.Contains(point)
+	})
+}
+
+// query returns an iterator over items in cells overlapped by area whose bounds pass the test.
+// Each item is reported only once, from the first cell shared with the area.
+func (g *Grid[T, V]) query(area geom.Rectangle[T], test func(bounds geom.Rectangle[T]) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		minCell, maxCell := g.Cell(area.Min()), g.Cell(area.Max())
+
+		for y := minCell.Y; y <= maxCell.Y; y++ {
+			for x := minCell.X; x <= maxCell.X; x++ {
+				for _, item := range g.cells[geom.Pt(x, y)] {
+					e := g.items[item]
+					if max(e.minCell.X, minCell.X) != x || max(e.minCell.Y, minCell.Y) != y {
+						continue
+					}
+
+					if test(e.bounds) && !yield(item) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// entry creates entry with the range of cells overlapped by bounds.
+func (g *Grid[T, V]) entry(bounds geom.Rectangle[T]) entry[T] {
+	return entry[T]{bounds, g.Cell(bounds.Min()), g.Cell(bounds.Max())}
+}
+
+// add adds the item to cells in range, skipping cells in range of the except entry.
+func (g *Grid[T, V]) add(item V, minCell, maxCell geom.Point[int], except *entry[T]) {
+	for y := minCell.Y; y <= maxCell.Y; y++ {
+		for x := minCell.X; x <= maxCell.X; x++ {
+			cell := geom.Pt(x, y)
+			if except != nil && cellInRange(cell, except.minCell, except.maxCell) {
+				continue
+			}
+
+			g.cells[cell] = append(g.cells[cell], item)
+		}
+	}
+}
+
+// remove removes the item from cells in range, skipping cells in range of the except entry.
+func (g *Grid[T, V]) remove(item V, minCell, maxCell geom.Point[int], except *entry[T]) {
+	for y := minCell.Y; y <= maxCell.Y; y++ {
+		for x := minCell.X; x <= maxCell.X; x++ {
+			cell := geom.Pt(x, y)
+			if except != nil && cellInRange(cell, except.minCell, except.maxCell) {
+				continue
+			}
+
+			items := g.cells[cell]
+			if i := slices.Index(items, item); i >= 0 {
+				items[i] = items[len(items)-1]
+				items = items[:len(items)-1]
+			}
+
+			if len(items) == 0 {
+				delete(g.cells, cell)
+			} else {
+				g.cells[cell] = items
+			}
+		}
+	}
+}
+
+// cellInRange checks if cell lies within inclusive range.
+func cellInRange(cell, minCell, maxCell geom.Point[int]) bool {
+	return minCell.X <= cell.X && cell.X <= maxCell.X && minCell.Y <= cell.Y && cell.Y <= maxCell.Y
+}
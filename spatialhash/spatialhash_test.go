@@ -0,0 +1,129 @@
+package spatialhash
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/gravitton/assert"
+	geom "github.com/gravitton/geometry"
+)
+
+var items = []geom.Rectangle[int]{
+	geom.RectFromMinMax(geom.Pt(2, 2), geom.Pt(8, 8)),
+	geom.RectFromMinMax(geom.Pt(5, 5), geom.Pt(25, 15)),
+	geom.RectFromMinMax(geom.Pt(-8, -8), geom.Pt(-2, -2)),
+}
+
+func TestNew(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	assert.Equal(t, grid.Len(), 0)
+	assert.Equal(t, len(slices.Collect(grid.QueryRect(geom.RectFromMinMax(geom.Pt(-100, -100), geom.Pt(100, 100))))), 0)
+	assert.False(t, grid.Remove(0))
+
+	_, ok := grid.Bounds(0)
+	assert.False(t, ok)
+}
+
+func TestNew_InvalidCellSize(t *testing.T) {
+	assert.Equal(t, New[float64, int](geom.Sz(0.0, 10.0)).CellBounds(geom.Pt(2, 3)), geom.RectFromMin(geom.Pt(2.0, 30.0), geom.Sz(1.0, 10.0)))
+	assert.Equal(t, New[float64, int](geom.Sz(10.0, -1.0)).Cell(geom.Pt(25.0, -2.5)), geom.Pt(2, -3))
+	assert.Equal(t, New[float64, int](geom.Sz(math.NaN(), 10.0)).Cell(geom.Pt(2.5, 25.0)), geom.Pt(2, 2))
+	assert.Equal(t, New[int, int](geom.Sz(0, 0)).Cell(geom.Pt(-3, 4)), geom.Pt(-3, 4))
+}
+
+func TestGrid_Cell(t *testing.T) {
+	grid := New[float64, int](geom.Sz(10.0, 20.0))
+
+	assert.Equal(t, grid.Cell(geom.Pt(0.0, 0.0)), geom.Pt(0, 0))
+	assert.Equal(t, grid.Cell(geom.Pt(15.0, 39.9)), geom.Pt(1, 1))
+	assert.Equal(t, grid.Cell(geom.Pt(-0.5, -20.0)), geom.Pt(-1, -1))
+	assert.True(t, grid.CellBounds(geom.Pt(-1, 2)).Equal(geom.RectFromMinMax(geom.Pt(-10.0, 40.0), geom.Pt(0.0, 60.0))))
+}
+
+func TestGrid_Insert(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	for i, bounds := range items {
+		grid.Insert(i, bounds)
+	}
+
+	assert.Equal(t, grid.Len(), 3)
+	assert.Equal(t, len(grid.cells), 7)
+	assert.Equal(t, slices.Sorted(slices.Values(grid.cells[geom.Pt(0, 0)])), []int{0, 1})
+	assert.Equal(t, grid.cells[geom.Pt(2, 1)], []int{1})
+	assert.Equal(t, grid.cells[geom.Pt(-1, -1)], []int{2})
+}
+
+func TestGrid_Remove(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	for i, bounds := range items {
+		grid.Insert(i, bounds)
+	}
+
+	assert.True(t, grid.Remove(1))
+	assert.False(t, grid.Remove(1))
+	assert.Equal(t, grid.Len(), 2)
+	assert.Equal(t, len(grid.cells), 2)
+
+	grid.Clear()
+	assert.Equal(t, grid.Len(), 0)
+	assert.Equal(t, len(grid.cells), 0)
+}
+
+func TestGrid_Update(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	for i, bounds := range items {
+		grid.Insert(i, bounds)
+	}
+
+	assert.True(t, grid.Update(0, geom.RectFromMinMax(geom.Pt(3, 3), geom.Pt(9, 9))))
+	assert.Equal(t, len(grid.cells), 7)
+
+	assert.True(t, grid.Update(1, geom.RectFromMinMax(geom.Pt(15, 5), geom.Pt(35, 8))))
+	assert.Equal(t, len(grid.cells), 5)
+	assert.Equal(t, grid.cells[geom.Pt(0, 0)], []int{0})
+	assert.Equal(t, grid.cells[geom.Pt(3, 0)], []int{1})
+
+	assert.False(t, grid.Update(3, geom.RectFromMinMax(geom.Pt(3, 3), geom.Pt(9, 9))))
+
+	bounds, ok := grid.Bounds(1)
+	assert.True(t, ok)
+	assert.True(t, bounds.Equal(geom.RectFromMinMax(geom.Pt(15, 5), geom.Pt(35, 8))))
+}
+
+func TestGrid_Query(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	for i, bounds := range items {
+		grid.Insert(i, bounds)
+	}
+
+	assert.Equal(t, slices.Sorted(grid.QueryRect(geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(30, 30)))), []int{0, 1})
+	assert.Equal(t, slices.Sorted(grid.QueryRect(geom.RectFromMinMax(geom.Pt(-5, -5), geom.Pt(4, 4)))), []int{0, 2})
+	assert.Equal(t, slices.Sorted(grid.QueryRect(geom.RectFromMinMax(geom.Pt(20, 12), geom.Pt(22, 14)))), []int{1})
+	assert.Equal(t, slices.Sorted(grid.QueryCircle(geom.Circ(geom.Pt(0, 0), 3))), []int{0, 2})
+	assert.Equal(t, slices.Sorted(grid.QueryPoint(geom.Pt(6, 6))), []int{0, 1})
+	assert.Equal(t, len(slices.Collect(grid.QueryPoint(geom.Pt(0, 0)))), 0)
+}
+
+func TestGrid_CellBoundary(t *testing.T) {
+	grid := New[int, int](geom.Sz(10, 10))
+	grid.Insert(0, geom.RectFromMinMax(geom.Pt(0, 0), geom.Pt(10, 10)))
+	grid.Insert(1, geom.Rect(geom.Pt(20, 5), geom.Sz(0, 0)))
+
+	// bounds ending on a cell edge occupy the next cell too
+	assert.Equal(t, len(grid.cells), 5)
+	assert.Equal(t, grid.cells[geom.Pt(1, 1)], []int{0})
+	assert.Equal(t, slices.Collect(grid.QueryPoint(geom.Pt(10, 10))), []int{0})
+	assert.Equal(t, slices.Collect(grid.QueryPoint(geom.Pt(20, 5))), []int{1})
+	assert.Equal(t, slices.Collect(grid.QueryRect(geom.Rect(geom.Pt(20, 5), geom.Sz(0, 0)))), []int{1})
+	assert.Equal(t, len(slices.Collect(grid.QueryPoint(geom.Pt(20, 6)))), 0)
+
+	// item spanning many cells is reported once
+	assert.Equal(t, slices.Sorted(grid.QueryRect(geom.RectFromMinMax(geom.Pt(-50, -50), geom.Pt(50, 50)))), []int{0, 1})
+
+	// removed item can be inserted again
+	assert.True(t, grid.Remove(1))
+	assert.Equal(t, len(slices.Collect(grid.QueryPoint(geom.Pt(20, 5)))), 0)
+	grid.Insert(1, geom.Rect(geom.Pt(20, 5), geom.Sz(0, 0)))
+	assert.Equal(t, slices.Collect(grid.QueryPoint(geom.Pt(20, 5))), []int{1})
+}
@@ -0,0 +1,154 @@
+package geom
+
+import (
+	"math"
+)
+
+// Impact is a result of a continuous (swept) collision test.
+type Impact struct {
+	// Time is fraction of the movement in range [0,1] at which the shapes touch.
+	Time float64
+	// Normal is unit surface normal of the static shape at the impact point.
+	Normal Vector[float64]
+}
+
+// SweepRectangles checks if the rectangle moving by velocity hits the static rectangle.
+// Already overlapping rectangles hit at time zero with normal of the nearest static rectangle side.
+// Touching rectangles hit at time zero only when moving into each other, so they can slide along or move apart.
+func SweepRectangles[T Number](rect Rectangle[T], velocity Vector[T], static Rectangle[T]) (Impact, bool) {
+	moving, target, v := rect.Float(), static.Float(), velocity.Float()
+
+	// Minkowski sum reduces the test to a ray cast of the moving rectangle center
+	expanded := target.GrowXY(moving.Size.XY())
+	ray := Ray[float64]{moving.Center, v}
+
+	offset := moving.Center.Subtract(target.Center)
+	extends := expanded.Size.Scale(0.5).Vector()
+	gapX, gapY := extends.X-math.Abs(offset.X), extends.Y-math.Abs(offset.Y)
+
+	if gapX >= -Delta && gapY >= -Delta {
+		// touching sides must be approached
+		approaching := (gapX > Delta || v.X*offset.X < 0) && (gapY > Delta || v.Y*offset.Y < 0)
+		if !approaching {
+			return Impact{}, false
+		}
+
+		return Impact{0, nearestSide(expanded, moving.Center)}, true
+	}
+
+	t, normal, ok := rayRectangle(ray, expanded)
+	if !ok || t > 1 {
+		return Impact{}, false
+	}
+
+	return Impact{t, normal}, true
+}
+
+// SweepCircles checks if the circle moving by velocity hits the static circle.
+// Already overlapping circles hit at time zero, touching ones only when moving into each other.
+func SweepCircles[T Number](circle Circle[T], velocity Vector[T], static Circle[T]) (Impact, bool) {
+	moving, target := circle.Float(), static.Float()
+
+	// Minkowski sum reduces the test to a ray cast of the moving circle center
+	ray := Ray[float64]{moving.Center, velocity.Float()}
+
+	offset := moving.Center.Subtract(target.Center)
+	if gap := offset.Length() - moving.Radius - target.Radius; gap <= Delta {
+		return restingImpact(gap, offset.Normalize(), ray.Direction)
+	}
+
+	t, ok := rayCircle(ray, target.Grow(moving.Radius))
+	if !ok || t > 1 {
+		return Impact{}, false
+	}
+
+	return Impact{t, ray.At(t).Subtract(target.Center).Normalize()}, true
+}
+
+// SweepCircleRectangle checks if the circle moving by velocity hits the static rectangle.
+// Already overlapping shapes hit at time zero, touching ones only when moving into each other.
+func SweepCircleRectangle[T Number](circle Circle[T], velocity Vector[T], static Rectangle[T]) (Impact, bool) {
+	return SweepCirclePolygon(circle, velocity, static.Polygon())
+}
+
+// SweepCirclePolygon checks if the circle moving by velocity hits any edge of the static polygon.
+// Already overlapping shapes hit at time zero, touching ones only when moving into each other.
+func SweepCirclePolygon[T Number](circle Circle[T], velocity Vector[T], static Polygon[T]) (Impact, bool) {
+	moving, vertices := circle.Float(), static.Float().Vertices
+	if len(vertices) == 0 {
+		return Impact{}, false
+	}
+
+	ray := Ray[float64]{moving.Center, velocity.Float()}
+
+	// nearest boundary point to check already overlapping shapes
+	nearest := closestBoundary(vertices, moving.Center)
+	normal := moving.Center.Subtract(nearest).Normalize()
+
+	if polygonContains(vertices, moving.Center) {
+		return Impact{0, normal.Negate()}, true
+	}
+	if gap := nearest.DistanceTo(moving.Center) - moving.Radius; gap <= Delta {
+		if impact, ok := restingImpact(gap, normal, ray.Direction); ok {
+			return impact, true
+		}
+	}
+
+	// ray cast of the moving circle center against capsules around polygon edges,
+	// only approached capsules are hit, so touching circle can slide along or move away from the polygon
+	impact, found := Impact{Time: math.Inf(1)}, false
+
+	for i, vertex := range vertices {
+		next := vertices[(i+1)%len(vertices)]
+
+		if t, ok := rayCircle(ray, Circle[float64]{vertex, moving.Radius}); ok && t < impact.Time {
+			if normal := ray.At(t).Subtract(vertex).Normalize(); normal.Dot(ray.Direction) < 0 {
+				impact, found = Impact{t, normal}, true
+			}
+		}
+
+		edge := next.Subtract(vertex)
+		if edge.IsZero() || equalDelta(edge.Cross(ray.Direction), 0, Delta*Delta) {
+			// ray parallel to edge only grazes its capsule
+			continue
+		}
+
+		offset := edge.Normal().Resize(moving.Radius)
+		for _, side := range []Vector[float64]{offset, offset.Negate()} {
+			if side.Dot(ray.Direction) >= 0 {
+				continue
+			}
+			if t, _, ok := raySegment(ray, vertex.Add(side), next.Add(side)); ok && t < impact.Time {
+				impact, found = Impact{t, side.Normalize()}, true
+			}
+		}
+	}
+
+	if !found || impact.Time > 1 {
+		return Impact{}, false
+	}
+
+	return impact, true
+}
+
+// restingImpact returns impact at time zero of shapes overlapping (negative gap) or touching (gap within Delta)
+// along the normal pointing from the static shape. Touching shapes hit only when velocity points into the static shape.
+func restingImpact(gap float64, normal, velocity Vector[float64]) (Impact, bool) {
+	if gap < -Delta || normal.Dot(velocity) < 0 {
+		return Impact{0, normal}, true
+	}
+
+	return Impact{}, false
+}
+
+// nearestSide returns outward normal of the rectangle side nearest to the point.
+func nearestSide(rect Rectangle[float64], point Point[float64]) Vector[float64] {
+	offset := point.Subtract(rect.Center)
+	extends := rect.Size.Scale(0.5).Vector()
+
+	if extends.Y-math.Abs(offset.Y) < extends.X-math.Abs(offset.X) {
+		return Vector[float64]{0, math.Copysign(1, offset.Y)}
+	}
+
+	return Vector[float64]{math.Copysign(1, offset.X), 0}
+}
@@ -0,0 +1,208 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+func TestSweepRectangles(t *testing.T) {
+	wall := Rect(Pt(10.0, 0.0), Sz(1.0, 10.0))
+	box := Rect(Pt(0.0, 0.0), Sz(2.0, 2.0))
+
+	impact, ok := SweepRectangles(box, Vec(20.0, 0.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 8.5/20, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	impact, ok = SweepRectangles(box.MoveTo(Pt(10.0, 20.0)), Vec(0.0, -20.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.7, Delta)
+	AssertVector(t, impact.Normal, 0, 1)
+
+	impact, ok = SweepRectangles(box.MoveTo(Pt(9.0, 0.0)), Vec(5.0, 0.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	_, ok = SweepRectangles(box, Vec(5.0, 0.0), wall)
+	assert.False(t, ok)
+	_, ok = SweepRectangles(box, Vec(-20.0, 0.0), wall)
+	assert.False(t, ok)
+	_, ok = SweepRectangles(box, Vec(20.0, 20.0), wall)
+	assert.False(t, ok)
+
+	impact, ok = SweepRectangles(Rect(Pt(0, 0), Sz(2, 2)), Vec(20, 0), Rect(Pt(10, 0), Sz(2, 10)))
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.4, Delta)
+}
+
+func TestSweepRectangles_Resting(t *testing.T) {
+	floor := RectFromMinMax(Pt(-10.0, 0.0), Pt(10.0, 2.0))
+	box := RectFromMinMax(Pt(0.0, -2.0), Pt(2.0, 0.0))
+
+	// sliding along the floor
+	_, ok := SweepRectangles(box, Vec(5.0, 0.0), floor)
+	assert.False(t, ok)
+	// lifting off the floor
+	_, ok = SweepRectangles(box, Vec(1.0, -3.0), floor)
+	assert.False(t, ok)
+	// pushing into the floor
+	impact, ok := SweepRectangles(box, Vec(1.0, 3.0), floor)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, 0, -1)
+
+	// touching corners moving diagonally into each other
+	corner := RectFromMinMax(Pt(-12.0, -2.0), Pt(-10.0, 0.0))
+	impact, ok = SweepRectangles(corner, Vec(1.0, 1.0), floor)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	_, ok = SweepRectangles(corner, Vec(1.0, -1.0), floor)
+	assert.False(t, ok)
+
+	// sliding along the floor into a wall
+	wall := RectFromMinMax(Pt(4.0, -4.0), Pt(5.0, 0.0))
+	impact, ok = SweepRectangles(box, Vec(4.0, 0.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.5, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	// integer rectangles resting on the floor
+	_, ok = SweepRectangles(Rect(Pt(0, -1), Sz(2, 2)), Vec(3, 0), Rect(Pt(0, 1), Sz(20, 2)))
+	assert.False(t, ok)
+}
+
+func TestSweepCircles(t *testing.T) {
+	target := Circ(Pt(10.0, 0.0), 2.0)
+	bullet := Circ(Pt(0.0, 0.0), 1.0)
+
+	impact, ok := SweepCircles(bullet, Vec(20.0, 0.0), target)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 7.0/20, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	impact, ok = SweepCircles(bullet.MoveTo(Pt(9.0, 0.0)), Vec(20.0, 0.0), target)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	_, ok = SweepCircles(bullet, Vec(5.0, 0.0), target)
+	assert.False(t, ok)
+	_, ok = SweepCircles(bullet.MoveTo(Pt(0.0, 3.5)), Vec(20.0, 0.0), target)
+	assert.False(t, ok)
+}
+
+func TestSweepCircles_Resting(t *testing.T) {
+	target := Circ(Pt(10.0, 0.0), 2.0)
+	ball := Circ(Pt(7.0, 0.0), 1.0)
+
+	// tangential and separating motion
+	_, ok := SweepCircles(ball, Vec(0.0, 5.0), target)
+	assert.False(t, ok)
+	_, ok = SweepCircles(ball, Vec(-5.0, 1.0), target)
+	assert.False(t, ok)
+
+	impact, ok := SweepCircles(ball, Vec(1.0, 1.0), target)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+}
+
+func TestSweepCircleRectangle(t *testing.T) {
+	wall := Rect(Pt(10.0, 0.0), Sz(1.0, 10.0))
+	bullet := Circ(Pt(0.0, 0.0), 1.0)
+
+	impact, ok := SweepCircleRectangle(bullet, Vec(100.0, 0.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 8.5/100, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	impact, ok = SweepCircleRectangle(bullet.MoveTo(Pt(0.0, -5.5)), Vec(100.0, 0.0), wall)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, (9.5-math.Sqrt(0.75))/100, Delta)
+	AssertVector(t, impact.Normal, -math.Sqrt(0.75), -0.5)
+
+	_, ok = SweepCircleRectangle(bullet.MoveTo(Pt(0.0, -6.5)), Vec(100.0, 0.0), wall)
+	assert.False(t, ok)
+	_, ok = SweepCircleRectangle(bullet, Vec(5.0, 0.0), wall)
+	assert.False(t, ok)
+}
+
+func TestSweepCircleRectangle_Resting(t *testing.T) {
+	floor := RectFromMinMax(Pt(-10.0, 0.0), Pt(10.0, 2.0))
+	ball := Circ(Pt(0.0, -1.0), 1.0)
+
+	// sliding along and lifting off the floor
+	_, ok := SweepCircleRectangle(ball, Vec(5.0, 0.0), floor)
+	assert.False(t, ok)
+	_, ok = SweepCircleRectangle(ball, Vec(1.0, -3.0), floor)
+	assert.False(t, ok)
+
+	impact, ok := SweepCircleRectangle(ball, Vec(1.0, 3.0), floor)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, 0, -1)
+
+	// touching the corner and moving around it
+	_, ok = SweepCircleRectangle(ball.MoveTo(Pt(11.0, 0.0)), Vec(0.0, 5.0), floor)
+	assert.False(t, ok)
+
+	// integer circle resting on the floor
+	_, ok = SweepCircleRectangle(Circ(Pt(0, -2), 2), Vec(4, 0), Rect(Pt(0, 1), Sz(20, 2)))
+	assert.False(t, ok)
+}
+
+func TestSweepCirclePolygon(t *testing.T) {
+	// concave "U" shape opened upwards
+	cup := Pol([]Point[float64]{{0, 0}, {1, 0}, {1, 4}, {3, 4}, {3, 0}, {4, 0}, {4, 5}, {0, 5}})
+	ball := Circ(Pt(2.0, -10.0), 0.5)
+
+	impact, ok := SweepCirclePolygon(ball, Vec(0.0, 20.0), cup)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 13.5/20, Delta)
+	AssertVector(t, impact.Normal, 0, -1)
+
+	impact, ok = SweepCirclePolygon(ball.MoveTo(Pt(1.2, 2.0)), Vec(0.0, 1.0), cup)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, 1, 0)
+
+	_, ok = SweepCirclePolygon(ball.MoveTo(Pt(2.0, 2.0)), Vec(0.0, 1.0), cup)
+	assert.False(t, ok)
+
+	_, ok = SweepCirclePolygon(ball, Vec(0.0, 5.0), cup)
+	assert.False(t, ok)
+	_, ok = SweepCirclePolygon(ball.MoveTo(Pt(-2.0, -10.0)), Vec(0.0, 20.0), cup)
+	assert.False(t, ok)
+}
+
+func TestSweepCirclePolygon_Resting(t *testing.T) {
+	// concave "U" shape opened upwards
+	cup := Pol([]Point[float64]{{0, 0}, {1, 0}, {1, 4}, {3, 4}, {3, 0}, {4, 0}, {4, 5}, {0, 5}})
+	ball := Circ(Pt(1.5, 3.5), 0.5)
+
+	// resting on the cup bottom, sliding into the wall
+	impact, ok := SweepCirclePolygon(ball, Vec(2.0, 0.0), cup)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.5, Delta)
+	AssertVector(t, impact.Normal, -1, 0)
+
+	// sliding along the bottom and lifting off
+	_, ok = SweepCirclePolygon(ball, Vec(0.5, 0.0), cup)
+	assert.False(t, ok)
+	_, ok = SweepCirclePolygon(ball, Vec(0.5, -2.0), cup)
+	assert.False(t, ok)
+
+	// touching the wall and the bottom in the corner
+	impact, ok = SweepCirclePolygon(ball, Vec(-1.0, 0.0), cup)
+	assert.True(t, ok)
+	assert.EqualDelta(t, impact.Time, 0.0, Delta)
+	AssertVector(t, impact.Normal, 1, 0)
+	_, ok = SweepCirclePolygon(ball, Vec(0.0, -1.0), cup)
+	assert.False(t, ok)
+	impact, ok = SweepCirclePolygon(ball, Vec(0.0, 1.0), cup)
+	assert.True(t, ok)
+	AssertVector(t, impact.Normal, 0, -1)
+}
@@ -82,6 +82,21 @@ func AssertLine[T Number](t *testing.T, l Line[T], sx, sy, ex, ey T, messages ..
 	return ok
 }
 
+func AssertRay[T Number](t *testing.T, r Ray[T], ox, oy, dx, dy T, messages ...string) bool {
+	t.Helper()
+
+	ok := true
+
+	if !AssertPoint(t, r.Origin, ox, oy, append(messages, "Origin.")...) {
+		ok = false
+	}
+	if !AssertVector(t, r.Direction, dx, dy, append(messages, "Direction.")...) {
+		ok = false
+	}
+
+	return ok
+}
+
 func AssertRect[T Number](t *testing.T, r Rectangle[T], cx, cy, w, h T, messages ...string) bool {
 	t.Helper()
 
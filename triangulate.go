@@ -0,0 +1,300 @@
+package geom
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Triangulate splits the simple polygon with optional holes into triangles using ear clipping.
+// Holes are bridged into the outer ring, they must lie inside the polygon and not overlap each other.
+// Triangles are returned as indices into polygon vertices followed by vertices of each hole,
+// they have the same winding as the polygon.
+func (p Polygon[T]) Triangulate(holes ...Polygon[T]) [][3]int {
+	outer := p.Float().Vertices
+	area := signedArea(outer)
+	if len(outer) < 3 || area == 0 {
+		return nil
+	}
+
+	start := ringNodes(outer, 0, true)
+	offset := len(outer)
+
+	holeStarts := make([]*ringNode, 0, len(holes))
+	for _, hole := range holes {
+		if node := ringNodes(hole.Float().Vertices, offset, false); node != nil {
+			holeStarts = append(holeStarts, leftmostNode(node))
+		}
+		offset += len(hole.Vertices)
+	}
+
+	slices.SortFunc(holeStarts, func(n1, n2 *ringNode) int {
+		return cmp.Or(cmp.Compare(n1.point.X, n2.point.X), cmp.Compare(n1.point.Y, n2.point.Y))
+	})
+	for _, hole := range holeStarts {
+		if bridge := holeBridge(hole, start); bridge != nil {
+			start = splitRing(bridge, hole)
+		}
+	}
+
+	triangles := clipEars(start)
+
+	// ring nodes are wound with positive signed area
+	if area < 0 {
+		for i, triangle := range triangles {
+			triangles[i] = [3]int{triangle[2], triangle[1], triangle[0]}
+		}
+	}
+
+	return triangles
+}
+
+// Triangles splits the simple polygon with optional holes into triangle polygons, see Triangulate.
+func (p Polygon[T]) Triangles(holes ...Polygon[T]) []Polygon[T] {
+	vertices := p.Vertices
+	for _, hole := range holes {
+		vertices = append(vertices[:len(vertices):len(vertices)], hole.Vertices...)
+	}
+
+	triangles := p.Triangulate(holes...)
+	polygons := make([]Polygon[T], len(triangles))
+	for i, triangle := range triangles {
+		polygons[i] = Polygon[T]{[]Point[T]{vertices[triangle[0]], vertices[triangle[1]], vertices[triangle[2]]}}
+	}
+
+	return polygons
+}
+
+// ringNode is a vertex in a circular doubly linked list.
+type ringNode struct {
+	index      int
+	point      Point[float64]
+	prev, next *ringNode
+}
+
+// ringNodes creates circular list of vertices with positive (outer) or negative (hole) signed area.
+// Consecutive duplicate vertices are skipped.
+func ringNodes(vertices []Point[float64], offset int, positive bool) *ringNode {
+	indices := make([]int, len(vertices))
+	for i := range indices {
+		indices[i] = i
+	}
+	if (signedArea(vertices) > 0) != positive {
+		slices.Reverse(indices)
+	}
+
+	var last *ringNode
+	for _, i := range indices {
+		if last != nil && last.point.Equal(vertices[i]) {
+			continue
+		}
+
+		node := &ringNode{index: offset + i, point: vertices[i]}
+		if last == nil {
+			node.prev, node.next = node, node
+		} else {
+			node.prev, node.next = last, last.next
+			last.next.prev = node
+			last.next = node
+		}
+		last = node
+	}
+
+	if last != nil && last != last.next && last.point.Equal(last.next.point) {
+		last.remove()
+		last = last.prev
+	}
+
+	return last
+}
+
+// leftmostNode returns ring node with minimal X (and then Y) coordinate.
+func leftmostNode(start *ringNode) *ringNode {
+	leftmost := start
+	for node := start.next; node != start; node = node.next {
+		if node.point.X < leftmost.point.X || (node.point.X == leftmost.point.X && node.point.Y < leftmost.point.Y) {
+			leftmost = node
+		}
+	}
+
+	return leftmost
+}
+
+// holeBridge finds outer ring node visible from the hole leftmost node, by casting a ray in -X direction.
+func holeBridge(hole, outer *ringNode) *ringNode {
+	point := hole.point
+	hitX := math.Inf(-1)
+	var candidate *ringNode
+
+	// find the nearest edge crossed by the ray, going in -Y direction (interior is on its +X side)
+	node := outer
+	for {
+		next := node.next
+		if point.Y <= node.point.Y && point.Y >= next.point.Y && next.point.Y != node.point.Y {
+			x := node.point.X + (point.Y-node.point.Y)*(next.point.X-node.point.X)/(next.point.Y-node.point.Y)
+			if x <= point.X && x > hitX {
+				hitX = x
+				candidate = node
+				if next.point.X < node.point.X {
+					candidate = next
+				}
+				if x == point.X {
+					// hole touches outer ring
+					return candidate
+				}
+			}
+		}
+
+		node = next
+		if node == outer {
+			break
+		}
+	}
+
+	if candidate == nil {
+		return nil
+	}
+
+	// vertices inside triangle of hole point, hit point and candidate may block the view,
+	// the visible one with minimal angle to the ray is chosen
+	hit := Point[float64]{hitX, point.Y}
+	bridge, minTangent := candidate, math.Inf(1)
+	node = candidate
+	for {
+		if point.X >= node.point.X && node.point.X >= candidate.point.X && point.X != node.point.X && triangleContains(point, hit, candidate.point, node.point) {
+			tangent := math.Abs(point.Y-node.point.Y) / (point.X - node.point.X)
+			if locallyInside(node, hole) && (tangent < minTangent || (tangent == minTangent && (node.point.X > bridge.point.X || (node.point.X == bridge.point.X && sectorContainsSector(bridge, node))))) {
+				bridge, minTangent = node, tangent
+			}
+		}
+
+		node = node.next
+		if node == candidate {
+			break
+		}
+	}
+
+	return bridge
+}
+
+// sectorContainsSector checks if the interior angle at node contains the interior angle at the other node with the same position.
+func sectorContainsSector(node, other *ringNode) bool {
+	return orientation(node.prev.point, node.point, other.prev.point) > 0 && orientation(other.next.point, node.point, node.next.point) > 0
+}
+
+// splitRing links outer node to hole node by a two-way bridge edge, returns the outer node.
+func splitRing(outer, hole *ringNode) *ringNode {
+	outer2 := &ringNode{index: outer.index, point: outer.point}
+	hole2 := &ringNode{index: hole.index, point: hole.point}
+	outerNext, holePrev := outer.next, hole.prev
+
+	outer.next, hole.prev = hole, outer
+	outer2.next, outerNext.prev = outerNext, outer2
+	hole2.next, outer2.prev = outer2, hole2
+	holePrev.next, hole2.prev = hole2, holePrev
+
+	return outer
+}
+
+// clipEars cuts ears off the ring until a single triangle remains.
+// Stalled ring is first cleaned of degenerate vertices, then convex vertices are clipped regardless of overlaps.
+func clipEars(ear *ringNode) [][3]int {
+	var triangles [][3]int
+	pass := 0
+	stop := ear
+	for ear.prev != ear.next {
+		prev, next := ear.prev, ear.next
+
+		if isEar(ear) || (pass == 2 && orientation(prev.point, ear.point, next.point) > 0) {
+			triangles = append(triangles, [3]int{prev.index, ear.index, next.index})
+			ear.remove()
+			ear, stop, pass = next.next, next.next, 0
+			continue
+		}
+
+		ear = next
+		if ear != stop {
+			continue
+		}
+
+		switch pass {
+		case 0:
+			ear = filterDegenerate(ear)
+			if ear == nil {
+				return triangles
+			}
+		case 2:
+			return triangles
+		}
+		stop = ear
+		pass++
+	}
+
+	return triangles
+}
+
+// isEar checks if ring node forms a convex corner with no other ring vertex inside its triangle.
+func isEar(ear *ringNode) bool {
+	a, b, c := ear.prev.point, ear.point, ear.next.point
+	if orientation(a, b, c) <= 0 {
+		return false
+	}
+
+	for node := ear.next.next; node != ear.prev; node = node.next {
+		if node.point.Equal(a) || node.point.Equal(b) || node.point.Equal(c) {
+			continue
+		}
+		if triangleContains(a, b, c, node.point) && orientation(node.prev.point, node.point, node.next.point) <= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterDegenerate removes duplicate and collinear ring nodes, returns nil if less than three nodes remain.
+func filterDegenerate(start *ringNode) *ringNode {
+	node := start
+	for {
+		if node.prev == node.next {
+			return nil
+		}
+
+		if node.point.Equal(node.next.point) || orientation(node.prev.point, node.point, node.next.point) == 0 {
+			node.remove()
+			node, start = node.prev, node.prev
+			continue
+		}
+
+		node = node.next
+		if node == start {
+			return node
+		}
+	}
+}
+
+// remove unlinks the node from its ring.
+func (n *ringNode) remove() {
+	n.prev.next, n.next.prev = n.next, n.prev
+}
+
+// locallyInside checks if the diagonal from the node to the other node goes inside the ring near the node.
+func locallyInside(node, other *ringNode) bool {
+	if orientation(node.prev.point, node.point, node.next.point) > 0 {
+		return orientation(node.point, other.point, node.next.point) <= 0 && orientation(node.point, node.prev.point, other.point) <= 0
+	}
+
+	return orientation(node.point, other.point, node.prev.point) > 0 || orientation(node.point, node.next.point, other.point) > 0
+}
+
+// orientation returns cross product of triangle edges, positive for triangles with positive signed area.
+func orientation(a, b, c Point[float64]) float64 {
+	return b.Subtract(a).Cross(c.Subtract(b))
+}
+
+// triangleContains checks if point lies inside the triangle of any winding or on its boundary.
+func triangleContains(a, b, c, point Point[float64]) bool {
+	o1, o2, o3 := orientation(a, b, point), orientation(b, c, point), orientation(c, a, point)
+
+	return (o1 >= 0 && o2 >= 0 && o3 >= 0) || (o1 <= 0 && o2 <= 0 && o3 <= 0)
+}
@@ -0,0 +1,97 @@
+package geom
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gravitton/assert"
+)
+
+// assertTriangulation checks number of triangles, their winding and total area.
+func assertTriangulation[T Number](t *testing.T, polygon Polygon[T], holes []Polygon[T], count int, area float64) {
+	t.Helper()
+
+	triangles := polygon.Triangles(holes...)
+	assert.Equal(t, len(triangles), count)
+
+	total := 0.0
+	for _, triangle := range triangles {
+		assert.Equal(t, triangle.Winding(), polygon.Winding())
+		total += triangle.Area()
+	}
+	assert.EqualDelta(t, total, area, 1e-6)
+}
+
+func TestPolygon_Triangulate(t *testing.T) {
+	assert.Equal(t, polygonInt.Triangulate(), [][3]int{{2, 3, 0}, {0, 1, 2}})
+	assert.Equal(t, polygonInt.Reverse().Triangulate(), [][3]int{{3, 0, 1}, {1, 2, 3}})
+
+	// concave L-shape
+	shape := Pol([]Point[int]{Pt(0, 0), Pt(6, 0), Pt(6, 2), Pt(2, 2), Pt(2, 6), Pt(0, 6)})
+	assertTriangulation(t, shape, nil, 4, 20)
+	assertTriangulation(t, shape.Reverse(), nil, 4, 20)
+
+	// collinear and duplicate vertices
+	assertTriangulation(t, Pol([]Point[int]{Pt(0, 0), Pt(2, 0), Pt(2, 0), Pt(4, 0), Pt(4, 4), Pt(0, 4), Pt(0, 0)}), nil, 3, 16)
+
+	// degenerate polygons
+	assert.Equal(t, len(Pol([]Point[int]{Pt(0, 0), Pt(1, 1), Pt(2, 2)}).Triangulate()), 0)
+	assert.Equal(t, len(Pol([]Point[int]{Pt(0, 0), Pt(1, 1)}).Triangulate()), 0)
+}
+
+func TestPolygon_TriangulateHoles(t *testing.T) {
+	square := RectFromMinMax(Pt(0, 0), Pt(10, 10)).Polygon()
+	hole1 := RectFromMinMax(Pt(2, 2), Pt(4, 4)).Polygon()
+	hole2 := RectFromMinMax(Pt(6, 5), Pt(8, 8)).Polygon().Reverse()
+
+	assertTriangulation(t, square, []Polygon[int]{hole1}, 8, 96)
+	assertTriangulation(t, square.Reverse(), []Polygon[int]{hole1}, 8, 96)
+	assertTriangulation(t, square, []Polygon[int]{hole1, hole2}, 14, 90)
+
+	// indices reference hole vertices after polygon vertices
+	used := make(map[int]bool)
+	for _, triangle := range square.Triangulate(hole1, hole2) {
+		for _, index := range triangle {
+			used[index] = true
+		}
+	}
+	assert.Equal(t, len(used), 12)
+
+	// ray from hole passing through bridge of another hole
+	holes := []Polygon[int]{
+		Pol([]Point[int]{Pt(-22, -24), Pt(-23, -22), Pt(-26, -21), Pt(-26, -24), Pt(-26, -27), Pt(-23, -26)}),
+		Pol([]Point[int]{Pt(-26, -11), Pt(-26, -5), Pt(-22, -8)}),
+		Pol([]Point[int]{Pt(-9, -27), Pt(-9, -22), Pt(-5, -24)}),
+	}
+	assertTriangulation(t, RectFromMinMax(Pt(-50, -50), Pt(50, 50)).Polygon(), holes, 20, 10000-17-12-10)
+
+	// hole touching outer boundary
+	assertTriangulation(t, square, []Polygon[int]{RectFromMinMax(Pt(0, 4), Pt(3, 6)).Polygon()}, 6, 94)
+}
+
+func TestPolygon_TriangulateRandom(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range 200 {
+		// star-shaped polygon with random radii is simple
+		n := 3 + random.IntN(30)
+		vertices := make([]Point[float64], n)
+		for i := range vertices {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			radius := 20 + random.Float64()*80
+			vertices[i] = Pt(radius*math.Cos(angle), radius*math.Sin(angle))
+		}
+		polygon := Pol(vertices)
+		hole := Circ(Pt(0.0, 0.0), 10.0)
+		holeVertices := make([]Point[float64], 8)
+		for i := range holeVertices {
+			angle := 2 * math.Pi * float64(i) / 8
+			holeVertices[i] = hole.Center.Add(Vec(math.Cos(angle), math.Sin(angle)).Multiply(hole.Radius))
+		}
+		holePolygon := Pol(holeVertices)
+
+		assertTriangulation(t, polygon, nil, n-2, polygon.Area())
+		assertTriangulation(t, polygon, []Polygon[float64]{holePolygon}, n+8, polygon.Area()-holePolygon.Area())
+	}
+}
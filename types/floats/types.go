@@ -10,9 +10,12 @@ type Vector = geom.Vector[float64]
 type Size = geom.Size[float64]
 type Circle = geom.Circle[float64]
 type Line = geom.Line[float64]
+type Ray = geom.Ray[float64]
 type Rectangle = geom.Rectangle[float64]
 type Polygon = geom.Polygon[float64]
 type RegularPolygon = geom.RegularPolygon[float64]
+type MultiPolygon = geom.MultiPolygon[float64]
+type Region = geom.Region[float64]
 type Padding = geom.Padding[float64]
 
 // Pt is shorthand for geom.Pt(x, y).Float()
@@ -40,6 +43,11 @@ func Ln[T geom.Number](start, end geom.Point[T]) Line {
 	return geom.Ln(start, end).Float()
 }
 
+// Ry is shorthand for geom.Ry(origin, direction).Float()
+func Ry[T geom.Number](origin geom.Point[T], direction geom.Vector[T]) Ray {
+	return geom.Ry(origin, direction).Float()
+}
+
 // Rect is shorthand for geom.Rect(center, size).Float()
 func Rect[T geom.Number](center geom.Point[T], size geom.Size[T]) Rectangle {
 	return geom.Rect(center, size).Float()
@@ -10,9 +10,12 @@ type Vector = geom.Vector[int]
 type Size = geom.Size[int]
 type Circle = geom.Circle[int]
 type Line = geom.Line[int]
+type Ray = geom.Ray[int]
 type Rectangle = geom.Rectangle[int]
 type Polygon = geom.Polygon[int]
 type RegularPolygon = geom.RegularPolygon[int]
+type MultiPolygon = geom.MultiPolygon[int]
+type Region = geom.Region[int]
 type Padding = geom.Padding[int]
 
 // Pt is shorthand for geom.Pt(x, y).Int()
@@ -40,6 +43,11 @@ func Ln[T geom.Number](start, end geom.Point[T]) Line {
 	return geom.Ln(start, end).Int()
 }
 
+// Ry is shorthand for geom.Ry(origin, direction).Int()
+func Ry[T geom.Number](origin geom.Point[T], direction geom.Vector[T]) Ray {
+	return geom.Ry(origin, direction).Int()
+}
+
 // Rect is shorthand for geom.Rect(center, size).Int()
 func Rect[T geom.Number](center geom.Point[T], size geom.Size[T]) Rectangle {
 	return geom.Rect(center, size).Int()